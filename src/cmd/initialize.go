@@ -146,6 +146,18 @@ func validateInitFlags() error {
 			return fmt.Errorf(lang.CmdInitErrValidateRegistry)
 		}
 	}
+
+	switch pkgConfig.InitOpts.ImagePolicy {
+	case "", config.ZarfImagePolicyOff, config.ZarfImagePolicyWarn, config.ZarfImagePolicyEnforce:
+	default:
+		return fmt.Errorf(lang.CmdInitErrValidateImagePolicy, pkgConfig.InitOpts.ImagePolicy)
+	}
+
+	switch pkgConfig.InitOpts.ImagePinning {
+	case "", config.ZarfImagePinningOff, config.ZarfImagePinningDigest:
+	default:
+		return fmt.Errorf(lang.CmdInitErrValidateImagePin, pkgConfig.InitOpts.ImagePinning)
+	}
 	return nil
 }
 
@@ -156,9 +168,12 @@ func init() {
 
 	// Init package variables
 	v.SetDefault(V_PKG_DEPLOY_SET, map[string]string{})
+	v.SetDefault(V_PKG_DEPLOY_CONCURRENCY, config.ZarfDefaultConcurrency)
 
 	v.SetDefault(V_INIT_COMPONENTS, "")
 	v.SetDefault(V_INIT_STORAGE_CLASS, "")
+	v.SetDefault(V_INIT_IMAGE_POLICY, "")
+	v.SetDefault(V_INIT_IMAGE_PINNING, "")
 
 	v.SetDefault(V_INIT_GIT_URL, "")
 	v.SetDefault(V_INIT_GIT_PUSH_USER, config.ZarfGitPushUser)
@@ -181,6 +196,9 @@ func init() {
 	initCmd.Flags().BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdInitFlagConfirm)
 	initCmd.Flags().StringVar(&pkgConfig.DeployOpts.Components, "components", v.GetString(V_INIT_COMPONENTS), lang.CmdInitFlagComponents)
 	initCmd.Flags().StringVar(&pkgConfig.InitOpts.StorageClass, "storage-class", v.GetString(V_INIT_STORAGE_CLASS), lang.CmdInitFlagStorageClass)
+	initCmd.Flags().StringVar(&pkgConfig.InitOpts.ImagePolicy, "image-policy", v.GetString(V_INIT_IMAGE_POLICY), lang.CmdInitFlagImagePolicy)
+	initCmd.Flags().StringVar(&pkgConfig.InitOpts.ImagePinning, "image-pinning", v.GetString(V_INIT_IMAGE_PINNING), lang.CmdInitFlagImagePinning)
+	initCmd.Flags().IntVar(&pkgConfig.DeployOpts.Concurrency, "concurrency", v.GetInt(V_PKG_DEPLOY_CONCURRENCY), lang.CmdInitFlagConcurrency)
 
 	// Flags for using an external Git server
 	initCmd.Flags().StringVar(&pkgConfig.InitOpts.GitServer.Address, "git-url", v.GetString(V_INIT_GIT_URL), lang.CmdInitFlagGitURL)
@@ -23,10 +23,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var insecureDeploy bool
-var shasum string
 var includeInspectSBOM bool
 var outputInspectSBOM string
+var rollbackGeneration int
 
 var packageCmd = &cobra.Command{
 	Use:     "package",
@@ -71,7 +70,7 @@ var packageCreateCmd = &cobra.Command{
 var packageDeployCmd = &cobra.Command{
 	Use:     "deploy [PACKAGE]",
 	Aliases: []string{"d"},
-	Short:   "Use to deploy a Zarf package from a local file or URL (runs offline)",
+	Short:   "Use to deploy a Zarf package from a local file, URL or OCI registry (runs offline)",
 	Long:    "Uses current kubecontext to deploy the packaged tarball onto a k8s cluster.",
 	Args:    cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -88,6 +87,29 @@ var packageDeployCmd = &cobra.Command{
 	},
 }
 
+var packagePublishCmd = &cobra.Command{
+	Use:   "publish [PACKAGE] [REFERENCE]",
+	Short: "Publish a Zarf package to an OCI registry",
+	Long: "Pushes a Zarf package to an OCI registry using the 'oci://' scheme (e.g. oci://registry.example.com/packages/app).\n" +
+		"Each component is stored as its own layer so 'zarf package deploy oci://...' only pulls the components being deployed.\n" +
+		"The tag defaults to the package version and architecture. Registries are accessed via credentials in your local '~/.docker/config.json'.",
+	Example: "  zarf package publish zarf-package-app-amd64-1.0.0.tar.zst oci://registry.example.com/packages/app",
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		pkgConfig.DeployOpts.PackagePath = args[0]
+		pkgConfig.PublishOpts.Reference = args[1]
+
+		// Configure the packager
+		pkgClient := packager.NewOrDie(&pkgConfig)
+		defer pkgClient.ClearTempPaths()
+
+		// Publish the package
+		if err := pkgClient.Publish(); err != nil {
+			message.Fatalf(err, "Failed to publish package: %s", err.Error())
+		}
+	},
+}
+
 var packageInspectCmd = &cobra.Command{
 	Use:     "inspect [PACKAGE]",
 	Aliases: []string{"i"},
@@ -110,6 +132,29 @@ var packageInspectCmd = &cobra.Command{
 	},
 }
 
+var packageVerifyCmd = &cobra.Command{
+	Use:     "verify [PACKAGE]",
+	Aliases: []string{"v"},
+	Short:   "Checks that a Zarf package has not been corrupted or changed (runs offline)",
+	Long: "Checks that a Zarf package has not been corrupted or changed (runs offline)\n" +
+		"Unpacks the package tarball into a temp directory and checks every file against the " +
+		"checksums recorded when the package was created. If --key is provided the package " +
+		"signature is verified as well.",
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		pkgConfig.DeployOpts.PackagePath = choosePackage(args)
+
+		// Configure the packager
+		pkgClient := packager.NewOrDie(&pkgConfig)
+		defer pkgClient.ClearTempPaths()
+
+		// Verify the package
+		if err := pkgClient.Verify(); err != nil {
+			message.Fatalf(err, "Failed to verify package: %s", err.Error())
+		}
+	},
+}
+
 var packageListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"l"},
@@ -123,7 +168,7 @@ var packageListCmd = &cobra.Command{
 
 		// Populate a pterm table of all the deployed packages
 		packageTable := pterm.TableData{
-			{"     Package ", "Components"},
+			{"     Package ", "Generation", "Components"},
 		}
 
 		for _, pkg := range deployedZarfPackages {
@@ -133,8 +178,14 @@ var packageListCmd = &cobra.Command{
 				components = append(components, component.Name)
 			}
 
+			generation := fmt.Sprintf("%d", pkg.Generation)
+			if pkg.Progress != nil {
+				generation += " (incomplete)"
+			}
+
 			packageTable = append(packageTable, pterm.TableData{{
 				fmt.Sprintf("     %s", pkg.Name),
+				generation,
 				fmt.Sprintf("%v", components),
 			}}...)
 		}
@@ -188,6 +239,23 @@ var packageRemoveCmd = &cobra.Command{
 	},
 }
 
+var packageRollbackCmd = &cobra.Command{
+	Use:   "rollback PACKAGE_NAME",
+	Args:  cobra.ExactArgs(1),
+	Short: "Use to roll back the charts of a deployed Zarf package to a previous generation",
+	Long: "Restores every helm chart of a deployed package, in reverse component order, to the revisions recorded " +
+		"for a previous deployment generation. Defaults to the generation before the current one.",
+	Run: func(cmd *cobra.Command, args []string) {
+		// Configure the packager
+		pkgClient := packager.NewOrDie(&pkgConfig)
+		defer pkgClient.ClearTempPaths()
+
+		if err := pkgClient.Rollback(args[0], rollbackGeneration); err != nil {
+			message.Fatalf(err, "Unable to roll back the package: %s", err.Error())
+		}
+	},
+}
+
 func choosePackage(args []string) string {
 	if len(args) > 0 {
 		return args[0]
@@ -220,13 +288,19 @@ func init() {
 	packageCmd.AddCommand(packageCreateCmd)
 	packageCmd.AddCommand(packageDeployCmd)
 	packageCmd.AddCommand(packageInspectCmd)
+	packageCmd.AddCommand(packageVerifyCmd)
+	packageCmd.AddCommand(packagePublishCmd)
 	packageCmd.AddCommand(packageRemoveCmd)
 	packageCmd.AddCommand(packageListCmd)
+	packageCmd.AddCommand(packageRollbackCmd)
 
 	bindCreateFlags()
 	bindDeployFlags()
 	bindInspectFlags()
+	bindVerifyFlags()
+	bindPublishFlags()
 	bindRemoveFlags()
+	bindRollbackFlags()
 }
 
 func bindCreateFlags() {
@@ -243,6 +317,10 @@ func bindCreateFlags() {
 	v.SetDefault(V_PKG_CREATE_INSECURE, false)
 	v.SetDefault(V_PKG_CREATE_MAX_PACKAGE_SIZE, 0)
 	v.SetDefault(V_PKG_CREATE_NO_LOCAL_IMAGES, false)
+	v.SetDefault(V_PKG_CREATE_DIFFERENTIAL, "")
+	v.SetDefault(V_PKG_CREATE_CONCURRENCY, config.ZarfDefaultConcurrency)
+	v.SetDefault(V_PKG_CREATE_SIGNING_KEY, "")
+	v.SetDefault(V_PKG_CREATE_SIGNING_KEY_PASS, "")
 
 	createFlags.StringToStringVar(&pkgConfig.CreateOpts.SetVariables, "set", v.GetStringMapString(V_PKG_CREATE_SET), "Specify package variables to set on the command line (KEY=value)")
 	createFlags.StringVarP(&pkgConfig.CreateOpts.OutputDirectory, "output-directory", "o", v.GetString(V_PKG_CREATE_OUTPUT_DIR), "Specify the output directory for the created Zarf package")
@@ -252,6 +330,11 @@ func bindCreateFlags() {
 	createFlags.BoolVar(&pkgConfig.CreateOpts.Insecure, "insecure", v.GetBool(V_PKG_CREATE_INSECURE), "Allow insecure registry connections when pulling OCI images")
 	createFlags.IntVarP(&pkgConfig.CreateOpts.MaxPackageSizeMB, "max-package-size", "m", v.GetInt(V_PKG_CREATE_MAX_PACKAGE_SIZE), "Specify the maximum size of the package in megabytes, packages larger than this will be split into multiple parts. Use 0 to disable splitting.")
 	createFlags.BoolVar(&pkgConfig.CreateOpts.NoLocalImages, "no-local-images", v.GetBool(V_PKG_CREATE_NO_LOCAL_IMAGES), "Do not use local container images when creating this package")
+	createFlags.IntVar(&pkgConfig.CreateOpts.Concurrency, "concurrency", v.GetInt(V_PKG_CREATE_CONCURRENCY), "Number of images to pull at the same time")
+	createFlags.BoolVar(&pkgConfig.CreateOpts.UpdateLock, "update-lock", false, "Pull the image tags instead of the digests locked in the zarf.lock and update the zarf.lock with the new digests")
+	createFlags.StringVar(&pkgConfig.CreateOpts.SigningKeyPath, "signing-key", v.GetString(V_PKG_CREATE_SIGNING_KEY), "Path to a cosign private key used to sign the package's zarf.yaml (which includes the checksum of every file in the package)")
+	createFlags.StringVar(&pkgConfig.CreateOpts.SigningKeyPassword, "signing-key-pass", v.GetString(V_PKG_CREATE_SIGNING_KEY_PASS), "Password of the cosign private key (defaults to COSIGN_PASSWORD or a prompt)")
+	createFlags.StringVar(&pkgConfig.CreateOpts.Differential, "differential", v.GetString(V_PKG_CREATE_DIFFERENTIAL), "Build a package that only contains the images and pinned git repos not already in the given previously built package (local path or oci://)")
 }
 
 func bindDeployFlags() {
@@ -265,11 +348,19 @@ func bindDeployFlags() {
 	v.SetDefault(V_PKG_DEPLOY_INSECURE, false)
 	v.SetDefault(V_PKG_DEPLOY_SHASUM, "")
 	v.SetDefault(V_PKG_DEPLOY_SGET, "")
+	v.SetDefault(V_PKG_DEPLOY_CONCURRENCY, config.ZarfDefaultConcurrency)
+	v.SetDefault(V_PKG_DEPLOY_PUBLIC_KEY, "")
+	v.SetDefault(V_PKG_DEPLOY_VALUES, map[string]string{})
 
 	deployFlags.StringToStringVar(&pkgConfig.DeployOpts.SetVariables, "set", v.GetStringMapString(V_PKG_DEPLOY_SET), "Specify deployment variables to set on the command line (KEY=value)")
+	deployFlags.StringToStringVar(&pkgConfig.DeployOpts.ValuesOverrides, "values", v.GetStringMapString(V_PKG_DEPLOY_VALUES), "Specify values files to merge on top of the packaged values of a chart (COMPONENT/CHART=values.yaml)")
 	deployFlags.StringVar(&pkgConfig.DeployOpts.Components, "components", v.GetString(V_PKG_DEPLOY_COMPONENTS), "Comma-separated list of components to install.  Adding this flag will skip the init prompts for which components to install")
-	deployFlags.BoolVar(&insecureDeploy, "insecure", v.GetBool(V_PKG_DEPLOY_INSECURE), "Skip shasum validation of remote package. Required if deploying a remote package and `--shasum` is not provided")
-	deployFlags.StringVar(&shasum, "shasum", v.GetString(V_PKG_DEPLOY_SHASUM), "Shasum of the package to deploy. Required if deploying a remote package and `--insecure` is not provided")
+	deployFlags.BoolVar(&pkgConfig.DeployOpts.Insecure, "insecure", v.GetBool(V_PKG_DEPLOY_INSECURE), "Skip shasum validation of remote package and allow plain HTTP OCI registries. Required if deploying a remote package and `--shasum` is not provided")
+	deployFlags.StringVar(&pkgConfig.DeployOpts.Shasum, "shasum", v.GetString(V_PKG_DEPLOY_SHASUM), "Shasum of the package to deploy. Required if deploying a remote package and `--insecure` is not provided")
+	deployFlags.BoolVar(&pkgConfig.DeployOpts.DryRun, "dry-run", false, "Report the namespaces, helm releases (with a diff against the live release), images, repos, scripts and files the deployment would change without changing the cluster")
+	deployFlags.BoolVar(&pkgConfig.DeployOpts.Resume, "resume", false, "Skip the components that were already deployed by an earlier attempt to deploy this same package that did not complete")
+	deployFlags.IntVar(&pkgConfig.DeployOpts.Concurrency, "concurrency", v.GetInt(V_PKG_DEPLOY_CONCURRENCY), "Number of images to push to the registry at the same time")
+	deployFlags.StringVarP(&pkgConfig.DeployOpts.PublicKeyPath, "key", "k", v.GetString(V_PKG_DEPLOY_PUBLIC_KEY), "Path to a cosign public key used to verify the package signature before it is extracted or deployed")
 	deployFlags.StringVar(&pkgConfig.DeployOpts.SGetKeyPath, "sget", v.GetString(V_PKG_DEPLOY_SGET), "Path to public sget key file for remote packages signed via cosign")
 }
 
@@ -277,11 +368,33 @@ func bindInspectFlags() {
 	inspectFlags := packageInspectCmd.Flags()
 	inspectFlags.BoolVarP(&includeInspectSBOM, "sbom", "s", false, "View SBOM contents while inspecting the package")
 	inspectFlags.StringVar(&outputInspectSBOM, "sbom-out", "", "Specify an output directory for the SBOMs from the inspected Zarf package")
+	inspectFlags.StringVarP(&pkgConfig.DeployOpts.PublicKeyPath, "key", "k", v.GetString(V_PKG_DEPLOY_PUBLIC_KEY), "Path to a cosign public key used to verify the package signature before it is inspected")
+}
+
+func bindVerifyFlags() {
+	verifyFlags := packageVerifyCmd.Flags()
+	verifyFlags.StringVarP(&pkgConfig.DeployOpts.PublicKeyPath, "key", "k", v.GetString(V_PKG_DEPLOY_PUBLIC_KEY), "Path to a cosign public key used to verify the package signature")
+}
+
+func bindPublishFlags() {
+	publishFlags := packagePublishCmd.Flags()
+
+	v.SetDefault(V_PKG_PUBLISH_INSECURE, false)
+
+	publishFlags.BoolVar(&pkgConfig.PublishOpts.Insecure, "insecure", v.GetBool(V_PKG_PUBLISH_INSECURE), "Allow plain HTTP connections to the OCI registry")
 }
 
 func bindRemoveFlags() {
 	removeFlags := packageRemoveCmd.Flags()
 	removeFlags.BoolVar(&config.CommonOptions.Confirm, "confirm", false, "REQUIRED. Confirm the removal action to prevent accidental deletions")
 	removeFlags.StringVar(&pkgConfig.DeployOpts.Components, "components", v.GetString(V_PKG_DEPLOY_COMPONENTS), "Comma-separated list of components to uninstall")
+	removeFlags.BoolVar(&pkgConfig.RemoveOpts.Purge, "purge", false, "Also remove the images (from the Zarf registry), git repos (from the Zarf git server), files and symlinks the components produced that are not used by other deployed components")
 	_ = packageRemoveCmd.MarkFlagRequired("confirm")
 }
+
+func bindRollbackFlags() {
+	rollbackFlags := packageRollbackCmd.Flags()
+	rollbackFlags.BoolVar(&config.CommonOptions.Confirm, "confirm", false, "REQUIRED. Confirm the rollback action to prevent accidental changes")
+	rollbackFlags.IntVar(&rollbackGeneration, "to", 0, "The package generation to roll back to (defaults to the previous generation)")
+	_ = packageRollbackCmd.MarkFlagRequired("confirm")
+}
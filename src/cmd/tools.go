@@ -8,13 +8,21 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/anchore/syft/cmd/syft/cli"
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
 	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/internal/packager/wait"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/pki"
+	"github.com/defenseunicorns/zarf/src/types"
 	k9s "github.com/derailed/k9s/cmd"
 	craneCmd "github.com/google/go-containerregistry/cmd/crane/cmd"
 	"github.com/google/go-containerregistry/pkg/crane"
@@ -23,6 +31,8 @@ import (
 )
 
 var subAltNames []string
+var waitNamespace string
+var waitTimeout time.Duration
 
 var toolsCmd = &cobra.Command{
 	Use:     "tools",
@@ -134,6 +144,160 @@ var generatePKICmd = &cobra.Command{
 	},
 }
 
+var waitForCmd = &cobra.Command{
+	Use:     "wait-for {KIND|PROTOCOL} {NAME|SELECTOR|ADDRESS} [CONDITION|JSONPATH=VALUE|CODE]",
+	Aliases: []string{"w", "wait"},
+	Short:   lang.CmdToolsWaitForShort,
+	Long:    lang.CmdToolsWaitForLong,
+	Example: lang.CmdToolsWaitForExample,
+	Args:    cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		condition := types.ZarfComponentWait{
+			MaxTotalSeconds: int(waitTimeout.Seconds()),
+		}
+
+		var state string
+		if len(args) > 2 {
+			state = args[2]
+		}
+
+		switch strings.ToLower(args[0]) {
+		case "tcp", "http", "https":
+			condition.Network = &types.ZarfComponentWaitNetwork{
+				Protocol: strings.ToLower(args[0]),
+				Address:  args[1],
+			}
+			if state != "" {
+				code, err := strconv.Atoi(state)
+				if err != nil {
+					message.Fatalf(err, lang.CmdToolsWaitForErrCode, state)
+				}
+				condition.Network.Code = code
+			}
+
+		default:
+			condition.Cluster = &types.ZarfComponentWaitCluster{
+				Kind:      args[0],
+				Name:      args[1],
+				Namespace: waitNamespace,
+			}
+			if strings.HasPrefix(state, "{") {
+				// A JSONPath, optionally followed by the value it must equal (e.g. {.status.phase}=Running)
+				jsonPath, value, _ := strings.Cut(state, "}=")
+				if value != "" {
+					jsonPath += "}"
+				}
+				condition.Cluster.JSONPath = jsonPath
+				condition.Cluster.Value = value
+			} else {
+				condition.Cluster.Condition = state
+			}
+		}
+
+		if err := wait.For(condition); err != nil {
+			message.Fatalf(err, lang.CmdToolsWaitForErr, wait.Describe(condition))
+		}
+	},
+}
+
+var updateCredsCmd = &cobra.Command{
+	Use:       "update-creds [registry|git|agent|all]",
+	Aliases:   []string{"uc"},
+	Short:     lang.CmdToolsUpdateCredsShort,
+	Long:      lang.CmdToolsUpdateCredsLong,
+	Example:   lang.CmdToolsUpdateCredsExample,
+	ValidArgs: []string{cluster.RegistryKey, cluster.GitKey, cluster.AgentKey, "all"},
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		services := []string{cluster.RegistryKey, cluster.GitKey, cluster.AgentKey}
+		if len(args) > 0 && args[0] != "all" {
+			services = []string{args[0]}
+		}
+
+		c := cluster.NewClusterOrDie()
+		state, err := c.LoadZarfState()
+		if err != nil || state.Distro == "" {
+			// If no distro the zarf secret did not load properly
+			message.Fatalf(nil, lang.ErrLoadState)
+		}
+
+		// Only rotate the credentials of the services Zarf manages
+		var toUpdate []string
+		for _, service := range services {
+			if _, err := cluster.RegenerateCredentials(state, service); err != nil {
+				message.Warnf(lang.CmdToolsUpdateCredsSkip, service, err.Error())
+				continue
+			}
+			toUpdate = append(toUpdate, service)
+		}
+		if len(toUpdate) == 0 {
+			message.Fatalf(nil, lang.CmdToolsUpdateCredsErrNone)
+		}
+
+		message.Question(fmt.Sprintf(lang.CmdToolsUpdateCredsAsk, strings.Join(toUpdate, ", ")))
+		if !config.CommonOptions.Confirm {
+			var confirm bool
+			prompt := &survey.Confirm{
+				Message: lang.CmdToolsUpdateCredsConfirm,
+			}
+			if err := survey.AskOne(prompt, &confirm); err != nil || !confirm {
+				message.Fatalf(nil, lang.CmdToolsUpdateCredsCancel)
+			}
+		}
+
+		h := helm.Helm{}
+		for _, service := range toUpdate {
+			newState, _ := cluster.RegenerateCredentials(state, service)
+
+			switch service {
+			case cluster.RegistryKey:
+				err = h.UpdateZarfRegistryValues(newState)
+
+			case cluster.GitKey:
+				// Change the admin password while the old one still works, so Gitea matches the new values whether or not it restarts
+				err = git.New(state.GitServer).UpdateGitUser(state.GitServer.PushPassword, newState.GitServer.PushUsername, newState.GitServer.PushPassword)
+				if err != nil {
+					break
+				}
+
+				// Gitea no longer accepts the old admin password, so save the new one before anything else can fail
+				state.GitServer.PushUsername = newState.GitServer.PushUsername
+				state.GitServer.PushPassword = newState.GitServer.PushPassword
+				if err := c.SaveZarfState(state); err != nil {
+					message.Fatalf(err, lang.CmdToolsUpdateCredsErrState)
+				}
+
+				err = h.UpdateZarfGiteaValues(newState)
+				if err == nil {
+					err = git.New(newState.GitServer).CreateReadOnlyUser()
+				}
+
+			case cluster.AgentKey:
+				err = c.UpdateAgentTLS(newState.AgentTLS)
+			}
+
+			if err != nil {
+				message.Fatalf(err, lang.CmdToolsUpdateCredsErr, service)
+			}
+
+			// Save the state after each service so it always matches what is deployed
+			state = newState
+			if err := c.SaveZarfState(state); err != nil {
+				message.Fatalf(err, lang.CmdToolsUpdateCredsErrState)
+			}
+
+			// Workloads pull from the registry and git server with the secrets in their namespace
+			if service != cluster.AgentKey {
+				if err := c.UpdateZarfManagedSecrets(state); err != nil {
+					message.Fatalf(err, lang.CmdToolsUpdateCredsErrSecrets)
+				}
+			}
+
+			message.SuccessF(lang.CmdToolsUpdateCredsSuccess, service)
+		}
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(toolsCmd)
 	toolsCmd.AddCommand(archiverCmd)
@@ -147,6 +311,13 @@ func init() {
 	toolsCmd.AddCommand(generatePKICmd)
 	generatePKICmd.Flags().StringArrayVar(&subAltNames, "sub-alt-name", []string{}, lang.CmdToolsGenPkiFlagAltName)
 
+	toolsCmd.AddCommand(updateCredsCmd)
+	updateCredsCmd.Flags().BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdToolsUpdateCredsFlagConfirm)
+
+	toolsCmd.AddCommand(waitForCmd)
+	waitForCmd.Flags().StringVarP(&waitNamespace, "namespace", "n", "", lang.CmdToolsWaitForFlagNamespace)
+	waitForCmd.Flags().DurationVar(&waitTimeout, "timeout", wait.DefaultTimeoutSeconds*time.Second, lang.CmdToolsWaitForFlagTimeout)
+
 	archiverCmd.AddCommand(archiverCompressCmd)
 	archiverCmd.AddCommand(archiverDecompressCmd)
 
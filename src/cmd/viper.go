@@ -24,6 +24,8 @@ const (
 	// Init config keys
 	V_INIT_COMPONENTS    = "init.components"
 	V_INIT_STORAGE_CLASS = "init.storage_class"
+	V_INIT_IMAGE_POLICY  = "init.image_policy"
+	V_INIT_IMAGE_PINNING = "init.image_pinning"
 
 	// Init Git config keys
 	V_INIT_GIT_URL       = "init.git.url"
@@ -50,13 +52,23 @@ const (
 	V_PKG_CREATE_INSECURE         = "package.create.insecure"
 	V_PKG_CREATE_MAX_PACKAGE_SIZE = "package.create.max_package_size"
 	V_PKG_CREATE_NO_LOCAL_IMAGES  = "package.create.no_local_images"
+	V_PKG_CREATE_DIFFERENTIAL     = "package.create.differential"
+	V_PKG_CREATE_CONCURRENCY      = "package.create.concurrency"
+	V_PKG_CREATE_SIGNING_KEY      = "package.create.signing_key"
+	V_PKG_CREATE_SIGNING_KEY_PASS = "package.create.signing_key_password"
 
 	// Package deploy config keys
-	V_PKG_DEPLOY_SET        = "package.deploy.set"
-	V_PKG_DEPLOY_COMPONENTS = "package.deploy.components"
-	V_PKG_DEPLOY_INSECURE   = "package.deploy.insecure"
-	V_PKG_DEPLOY_SHASUM     = "package.deploy.shasum"
-	V_PKG_DEPLOY_SGET       = "package.deploy.sget"
+	V_PKG_DEPLOY_SET         = "package.deploy.set"
+	V_PKG_DEPLOY_COMPONENTS  = "package.deploy.components"
+	V_PKG_DEPLOY_INSECURE    = "package.deploy.insecure"
+	V_PKG_DEPLOY_SHASUM      = "package.deploy.shasum"
+	V_PKG_DEPLOY_SGET        = "package.deploy.sget"
+	V_PKG_DEPLOY_CONCURRENCY = "package.deploy.concurrency"
+	V_PKG_DEPLOY_PUBLIC_KEY  = "package.deploy.public_key"
+	V_PKG_DEPLOY_VALUES      = "package.deploy.values"
+
+	// Package publish config keys
+	V_PKG_PUBLISH_INSECURE = "package.publish.insecure"
 )
 
 func initViper() {
@@ -36,6 +36,12 @@ const (
 	ZarfGeneratedPasswordLen = 24
 	ZarfGeneratedSecretLen   = 48
 
+	// ZarfMaskedValue replaces secret values in debug output
+	ZarfMaskedValue = "**sanitized**"
+
+	// ZarfDefaultConcurrency is the default number of images pulled or pushed at the same time
+	ZarfDefaultConcurrency = 4
+
 	ZarfAgentHost = "agent-hook.zarf.svc"
 
 	ZarfConnectLabelName             = "zarf.dev/connect-name"
@@ -49,14 +55,34 @@ const (
 	ZarfGitCacheDir   = "repos"
 
 	ZarfYAML          = "zarf.yaml"
+	ZarfLock          = "zarf.lock"
+	ZarfYAMLSignature = "zarf.yaml.sig"
+	ZarfChecksumsTxt  = "checksums.txt"
 	ZarfSBOMDir       = "zarf-sbom"
 	ZarfPackagePrefix = "zarf-package-"
 
-	ZarfInClusterContainerRegistryURL      = "http://zarf-registry-http.zarf.svc.cluster.local:5000"
+	// ZarfVariablesPrefix names the secrets holding the variable values of deployed packages (kept apart from the
+	// package secrets so the values are not shown with the package and cannot collide with a package named *-variables)
+	ZarfVariablesPrefix = "zarf-variables-"
+
+	// ZarfGenerationPrefix names the secrets holding the package definition of each generation of a deployed package
+	// (kept apart from the package secret so the history does not grow it past the size limit of a secret)
+	ZarfGenerationPrefix = "zarf-generation-"
+
+	ZarfInClusterContainerRegistryURL      = "http://zarf-docker-registry.zarf.svc.cluster.local:5000"
 	ZarfInClusterContainerRegistryNodePort = 31999
 
 	ZarfInClusterGitServiceURL = "http://zarf-gitea-http.zarf.svc.cluster.local:3000"
 
+	// How the Zarf Agent handles pods with images that are missing from the Zarf registry
+	ZarfImagePolicyOff     = "off"
+	ZarfImagePolicyWarn    = "warn"
+	ZarfImagePolicyEnforce = "enforce"
+
+	// How the Zarf Agent references the images of pods in the Zarf registry
+	ZarfImagePinningOff    = "off"
+	ZarfImagePinningDigest = "digest"
+
 	ZarfSeedImage = "registry"
 	ZarfSeedTag   = "2.8.1"
 )
@@ -107,11 +107,13 @@ const (
 		"# Initializing w/ an external registry:\nzarf init --registry-push-password={PASSWORD} --registry-push-username={USERNAME} --registry-url={URL}\n\n" +
 		"# Initializing w/ an external git server:\nzarf init --git-push-password={PASSWORD} --git-push-username={USERNAME} --git-url={URL}\n\n"
 
-	CmdInitErrFlags             = "Invalid command flags were provided."
-	CmdInitErrDownload          = "failed to download the init package: %s"
-	CmdInitErrValidateGit       = "the 'git-push-username' and 'git-push-password' flags must be provided if the 'git-url' flag is provided"
-	CmdInitErrValidateRegistry  = "the 'registry-push-username' and 'registry-push-password' flags must be provided if the 'registry-url' flag is provided "
-	CmdInitErrUnableCreateCache = "Unable to create the cache directory: %s"
+	CmdInitErrFlags               = "Invalid command flags were provided."
+	CmdInitErrDownload            = "failed to download the init package: %s"
+	CmdInitErrValidateGit         = "the 'git-push-username' and 'git-push-password' flags must be provided if the 'git-url' flag is provided"
+	CmdInitErrValidateRegistry    = "the 'registry-push-username' and 'registry-push-password' flags must be provided if the 'registry-url' flag is provided "
+	CmdInitErrValidateImagePolicy = "the 'image-policy' flag must be one of off, warn or enforce, not %s"
+	CmdInitErrValidateImagePin    = "the 'image-pinning' flag must be one of off or digest, not %s"
+	CmdInitErrUnableCreateCache   = "Unable to create the cache directory: %s"
 
 	CmdInitDownloadAsk       = "It seems the init package could not be found locally, but can be downloaded from %s"
 	CmdInitDownloadNote      = "Note: This will require an internet connection."
@@ -124,6 +126,9 @@ const (
 	CmdInitFlagConfirm      = "Confirm the install without prompting"
 	CmdInitFlagComponents   = "Specify which optional components to install.  E.g. --components=git-server,logging"
 	CmdInitFlagStorageClass = "Specify the storage class to use for the registry.  E.g. --storage-class=standard"
+	CmdInitFlagConcurrency  = "Number of images to push to the registry at the same time"
+	CmdInitFlagImagePolicy  = "How the Zarf Agent handles pods with images that are missing from the Zarf registry (off, warn or enforce). Defaults to off for new clusters and keeps the current policy otherwise"
+	CmdInitFlagImagePinning = "How the Zarf Agent references the images of pods in the Zarf registry (off or digest). With digest, images are pinned to the digests recorded by their packages. Defaults to off for new clusters and keeps the current setting otherwise"
 
 	CmdInitFlagGitURL      = "External git server url to use for this Zarf cluster"
 	CmdInitFlagGitPushUser = "Username to access to the git server Zarf is configured to use. User must be able to create repositories via 'git push'"
@@ -165,6 +170,51 @@ const (
 	CmdToolsGenPkiSuccess     = "Successfully created a chain of trust for %s"
 	CmdToolsGenPkiFlagAltName = "Specify Subject Alternative Names for the certificate"
 
+	CmdToolsUpdateCredsShort = "Updates the credentials for deployed Zarf services. Pass a service key to update credentials for a single service"
+	CmdToolsUpdateCredsLong  = "Generates new credentials for the Zarf registry, git server and agent (or only the given service), saves them to the\n" +
+		"zarf-state secret, restarts the service to use them and refreshes the private-registry and private-git-server secrets in every\n" +
+		"namespace Zarf manages. Credentials for an external registry or git server are not generated by Zarf and are left as they are."
+	CmdToolsUpdateCredsExample = `  # Update all Zarf credentials
+  $ zarf tools update-creds
+
+  # Update the credentials of a single service
+  $ zarf tools update-creds registry
+  $ zarf tools update-creds git
+  $ zarf tools update-creds agent`
+	CmdToolsUpdateCredsAsk         = "New credentials will be generated for: %s. The services will be restarted to use them."
+	CmdToolsUpdateCredsConfirm     = "Continue with these changes?"
+	CmdToolsUpdateCredsCancel      = "Credential update canceled"
+	CmdToolsUpdateCredsSkip        = "Skipping the %s credentials: %s"
+	CmdToolsUpdateCredsSuccess     = "Successfully updated the %s credentials"
+	CmdToolsUpdateCredsErr         = "Unable to update the %s credentials"
+	CmdToolsUpdateCredsErrNone     = "There are no credentials managed by Zarf to update"
+	CmdToolsUpdateCredsErrSecrets  = "Unable to update the Zarf pull secrets"
+	CmdToolsUpdateCredsErrState    = "Unable to save the new credentials to the Zarf state"
+	CmdToolsUpdateCredsFlagConfirm = "Confirm updating the credentials without prompting"
+
+	CmdToolsWaitForShort = "Waits for a given Kubernetes resource or network endpoint to be ready"
+	CmdToolsWaitForLong  = "Waits for a Kubernetes resource to exist and optionally meet a condition (e.g. Ready) or have a value at a JSONPath,\n" +
+		"or for a tcp, http or https endpoint to respond. This uses the same checks as the wait field of a component, without kubectl or curl."
+	CmdToolsWaitForExample = `  # Wait for a deployment to be available
+  $ zarf tools wait-for deployment podinfo available -n podinfo
+
+  # Wait for every pod matching a label selector to be ready
+  $ zarf tools wait-for pod app=podinfo ready -n podinfo
+
+  # Wait for a value at a JSONPath
+  $ zarf tools wait-for pod my-pod '{.status.phase}=Running' -n my-namespace
+
+  # Wait for a resource to exist
+  $ zarf tools wait-for storageclass standard
+
+  # Wait for network endpoints
+  $ zarf tools wait-for http localhost:8080 200
+  $ zarf tools wait-for tcp localhost:5432`
+	CmdToolsWaitForErr           = "Unable to wait for %s"
+	CmdToolsWaitForErrCode       = "Invalid HTTP status code %s"
+	CmdToolsWaitForFlagNamespace = "Specify the namespace of the resource to wait for"
+	CmdToolsWaitForFlagTimeout   = "Specify the maximum time to wait"
+
 	CmdToolsSbomShort = "Generates a Software Bill of Materials (SBOM) for the given package"
 	CmdToolsSbomErr   = "Unable to create sbom (syft) CLI"
 
@@ -184,11 +234,19 @@ const (
 	AgentInfoShutdown       = "Shutdown gracefully..."
 	AgentInfoPort           = "Server running in port: %s"
 
+	AgentWarnFluxSource   = "Zarf Agent: Zarf does not mirror Flux %s sources, so %s still points at %s and must be reachable from the cluster"
+	AgentWarnFluxInsecure = "Zarf Agent: the %s %s (%s) has no spec.insecure field to pull from the Zarf registry over HTTP, use source.toolkit.fluxcd.io/v1 or set spec.insecure if your Flux version supports it"
+	AgentWarnImageCheck   = "Zarf Agent image policy: unable to check the Zarf registry for the image %s, so it is allowed: %s"
+	AgentWarnImagePolicy  = "Zarf Agent image policy: %s"
+	AgentWarnWatchState   = "Unable to watch the Zarf state, reading it from the mounted file instead: %s"
+	AgentWarnWatchDigests = "Unable to watch the image digests, pods will not be pinned to digests until they are synced: %s"
+
 	AgentErrBadRequest             = "could not read request body: %s"
 	AgentErrBindHandler            = "Unable to bind the webhook handler"
 	AgentErrCouldNotDeserializeReq = "could not deserialize request: %s"
-	AgentErrGetState               = "failed to load zarf state from file: %w"
+	AgentErrGetState               = "failed to load zarf state: %w"
 	AgentErrHostnameMatch          = "failed to complete hostname matching: %w"
+	AgentErrImagePolicy            = "denied by the Zarf Agent image policy: %s"
 	AgentErrImageSwap              = "Unable to swap the host for (%s)"
 	AgentErrInvalidMethod          = "invalid method only POST requests are allowed"
 	AgentErrInvalidOp              = "invalid operation: %s"
@@ -202,6 +260,10 @@ const (
 
 // src/internal/packager/validate.
 const (
+	PkgValidateErrAction                  = "invalid action in component %s: %w"
+	PkgValidateErrActionCmdMissing        = "action must include a cmd"
+	PkgValidateErrActionLimits            = "action %s cannot have a negative maxRetries or maxTotalSeconds"
+	PkgValidateErrActionSetVariable       = "action %s can only set a variable in onDeploy"
 	PkgValidateErrChart                   = "invalid chart definition: %w"
 	PkgValidateErrChartName               = "chart %s exceed the maximum length of %d characters"
 	PkgValidateErrChartNameMissing        = "chart %s must include a name"
@@ -209,11 +271,18 @@ const (
 	PkgValidateErrChartURLOrPath          = "chart %s must only have a url or localPath"
 	PkgValidateErrChartVersion            = "chart %s must include a chart version"
 	PkgValidateErrComponentNameNotUnique  = "component name '%s' is not unique"
+	PkgValidateErrComponentDependsCycle   = "component dependency cycle detected: %s"
+	PkgValidateErrComponentDependsMissing = "component %s depends on component %s which does not exist in this package"
+	PkgValidateErrComponentDependsSelf    = "component %s cannot depend on itself"
 	PkgValidateErrComponent               = "invalid component: %w"
 	PkgValidateErrComponentReqDefault     = "component %s cannot be both required and default"
 	PkgValidateErrComponentReqGrouped     = "component %s cannot be both required and grouped"
 	PkgValidateErrComponentYOLO           = "component %s incompatible with the online-only package flag (metadata.yolo): %w"
 	PkgValidateErrConstant                = "invalid package constant: %w"
+	PkgValidateErrDataInjection           = "invalid data injection in component %s: %w"
+	PkgValidateErrDataInjectionPVC        = "data injection into the PVC %s cannot also have a selector or container"
+	PkgValidateErrDataInjectionTarget     = "data injection into %s must have either a pvc, or a selector and a container"
+	PkgValidateErrDataInjectionTimeout    = "data injection into %s cannot have a negative maxTotalSeconds"
 	PkgValidateErrImportPathInvalid       = "invalid file path \"%s\" provided directory must contain a valid zarf.yaml file"
 	PkgValidateErrImportPathMissing       = "imported package %s must include a path"
 	PkgValidateErrInitNoYOLO              = "sorry, you can't YOLO an init package"
@@ -226,6 +295,25 @@ const (
 	PkgValidateErrPkgName                 = "package name '%s' must be all lowercase and contain no special characters except -"
 	PkgValidateErrPkgVariableName         = "variable name '%s' must be all uppercase and contain no special characters except _"
 	PkgValidateErrVariable                = "invalid package variable: %w"
+	PkgValidateErrVariableAutoGenerate    = "variable %s can only be auto-generated if its type is string"
+	PkgValidateErrVariableDefault         = "default value of variable %s is invalid: %w"
+	PkgValidateErrVariableGeneratePattern = "variable %s can not have a pattern since it is auto-generated"
+	PkgValidateErrVariablePath            = "variable %s has an invalid path %s, it must be <value path> or <component>/<chart>:<value path>"
+	PkgValidateErrVariablePathChart       = "variable %s has a path for %s but there is no chart with that <component>/<chart> name in the package"
+	PkgValidateErrVariablePattern         = "variable %s has an invalid pattern: %w"
+	PkgValidateErrVariableType            = "variable %s has an invalid type %s, it must be one of string, int, bool or file"
+	PkgValidateErrVariableValueBool       = "value of variable %s must be a bool (true or false)"
+	PkgValidateErrVariableValueFile       = "value of variable %s must be the path of an existing file"
+	PkgValidateErrVariableValueInt        = "value of variable %s must be an int"
+	PkgValidateErrVariableValuePattern    = "value of variable %s does not match the pattern %s"
+	PkgValidateErrWait                    = "invalid wait in component %s: %w"
+	PkgValidateErrWaitClusterCondition    = "wait for %s %s can only have a condition or a jsonPath"
+	PkgValidateErrWaitClusterMissing      = "cluster wait must include a kind and a name"
+	PkgValidateErrWaitClusterValue        = "wait for %s %s can only have a value with a jsonPath"
+	PkgValidateErrWaitNetworkAddress      = "network wait must include an address"
+	PkgValidateErrWaitNetworkProtocol     = "network wait has an invalid protocol %s, it must be one of tcp, http or https"
+	PkgValidateErrWaitTarget              = "wait must include either a cluster resource or a network endpoint"
+	PkgValidateErrWaitTimeout             = "wait cannot have a negative maxTotalSeconds"
 	PkgValidateErrYOLONoArch              = "cluster architecture not allowed"
 	PkgValidateErrYOLONoDistro            = "cluster distros not allowed"
 	PkgValidateErrYOLONoGit               = "git repos not allowed"
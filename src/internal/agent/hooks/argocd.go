@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks contains the mutation hooks for the Zarf agent.
+package hooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/agent/operations"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Labels and secret types used by Argo CD to find its repository secrets.
+const (
+	argoSecretTypeLabel     = "argocd.argoproj.io/secret-type"
+	argoSecretTypeRepoCreds = "repo-creds"
+	argoRepoTypeGit         = "git"
+)
+
+// ArgoSource contains the repository of an Argo CD Application source.
+type ArgoSource struct {
+	RepoURL string `json:"repoURL"`
+	Chart   string `json:"chart,omitempty"`
+}
+
+// ArgoApplicationSpec contains the sources of an Argo CD Application.
+type ArgoApplicationSpec struct {
+	Source  *ArgoSource  `json:"source,omitempty"`
+	Sources []ArgoSource `json:"sources,omitempty"`
+}
+
+// ArgoApplication contains the fields of an Argo CD Application or ApplicationSet that point to git repositories.
+type ArgoApplication struct {
+	Spec struct {
+		ArgoApplicationSpec
+		Template struct {
+			Spec ArgoApplicationSpec `json:"spec"`
+		} `json:"template"`
+		Generators []struct {
+			Git *ArgoSource `json:"git,omitempty"`
+		} `json:"generators,omitempty"`
+	} `json:"spec"`
+}
+
+// NewArgoApplicationMutationHook creates a new instance of the Argo CD Application and ApplicationSet mutation hook.
+func NewArgoApplicationMutationHook() operations.Hook {
+	message.Debug("hooks.NewArgoApplicationMutationHook()")
+	return operations.Hook{
+		Create: mutateArgoApplication,
+		Update: mutateArgoApplication,
+	}
+}
+
+// NewArgoRepositoryMutationHook creates a new instance of the Argo CD repository secret mutation hook.
+func NewArgoRepositoryMutationHook() operations.Hook {
+	message.Debug("hooks.NewArgoRepositoryMutationHook()")
+	return operations.Hook{
+		Create: mutateArgoRepository,
+		Update: mutateArgoRepository,
+	}
+}
+
+// mutateArgoApplication mutates the git repository urls of an Application or ApplicationSet to point to the git server defined in the ZarfState.
+func mutateArgoApplication(r *v1.AdmissionRequest) (result *operations.Result, err error) {
+	var patches []operations.PatchOperation
+
+	state, err := getState()
+	if err != nil {
+		return nil, fmt.Errorf(lang.AgentErrGetState, err)
+	}
+
+	message.Debugf("Using the url of (%s) to mutate the Argo CD %s", state.GitServer.Address, r.Kind.Kind)
+
+	// parse to simple struct to read the git urls
+	app := &ArgoApplication{}
+	if err = json.Unmarshal(r.Object.Raw, &app); err != nil {
+		return nil, fmt.Errorf(lang.ErrUnmarshal, err)
+	}
+
+	patchSources := func(spec ArgoApplicationSpec, path string) {
+		if spec.Source != nil {
+			patches = append(patches, patchArgoSource(state, *spec.Source, path+"/source/repoURL")...)
+		}
+		for idx, source := range spec.Sources {
+			patches = append(patches, patchArgoSource(state, source, fmt.Sprintf("%s/sources/%d/repoURL", path, idx))...)
+		}
+	}
+
+	if r.Kind.Kind == "ApplicationSet" {
+		// Applications generated from the template are mutated as well, but patching the template keeps the set in sync with them
+		patchSources(app.Spec.Template.Spec, "/spec/template/spec")
+		for idx, generator := range app.Spec.Generators {
+			if generator.Git != nil {
+				patches = append(patches, patchArgoSource(state, *generator.Git, fmt.Sprintf("/spec/generators/%d/git/repoURL", idx))...)
+			}
+		}
+	} else {
+		patchSources(app.Spec.ArgoApplicationSpec, "/spec")
+	}
+
+	return &operations.Result{
+		Allowed:  true,
+		PatchOps: patches,
+	}, nil
+}
+
+// patchArgoSource returns the patch that points a git source at the Zarf git server, skipping helm chart sources.
+func patchArgoSource(state types.ZarfState, source ArgoSource, path string) []operations.PatchOperation {
+	if source.Chart != "" || source.RepoURL == "" {
+		return nil
+	}
+
+	return []operations.PatchOperation{operations.ReplacePatchOperation(path, mutateGitURL(state, source.RepoURL))}
+}
+
+// mutateArgoRepository mutates the url of an Argo CD git repository secret to point to the git server defined in the ZarfState
+// and replaces its credentials with the read-only credentials of that git server. Helm and OCI repository secrets are left as they are.
+func mutateArgoRepository(r *v1.AdmissionRequest) (result *operations.Result, err error) {
+	var patches []operations.PatchOperation
+
+	state, err := getState()
+	if err != nil {
+		return nil, fmt.Errorf(lang.AgentErrGetState, err)
+	}
+
+	secret := &corev1.Secret{}
+	if err = json.Unmarshal(r.Object.Raw, &secret); err != nil {
+		return nil, fmt.Errorf(lang.ErrUnmarshal, err)
+	}
+
+	// Merge the string data into the data the same way the API server does
+	data := map[string][]byte{}
+	for key, value := range secret.Data {
+		data[key] = value
+	}
+	for key, value := range secret.StringData {
+		data[key] = []byte(value)
+	}
+
+	// Helm and OCI repositories are not served by the Zarf git server, so leave their secrets as they are
+	if repoType := string(data["type"]); (repoType != "" && repoType != argoRepoTypeGit) || string(data["enableOCI"]) == "true" {
+		message.Debugf("Skipping the Argo CD repository secret %s as it is not for a git repository", secret.Name)
+		return &operations.Result{Allowed: true}, nil
+	}
+
+	url := string(data["url"])
+	if url != "" {
+		switch secret.Labels[argoSecretTypeLabel] {
+		case argoSecretTypeRepoCreds:
+			// Credential templates match every repository url with this prefix, and Zarf keeps every repository under the push user
+			url = fmt.Sprintf("%s/%s", strings.TrimSuffix(state.GitServer.Address, "/"), state.GitServer.PushUsername)
+		default:
+			url = mutateGitURL(state, url)
+		}
+		data["url"] = []byte(url)
+	}
+
+	data["username"] = []byte(state.GitServer.PullUsername)
+	data["password"] = []byte(state.GitServer.PullPassword)
+
+	patches = append(patches, operations.AddPatchOperation("/data", data))
+	if len(secret.StringData) > 0 {
+		patches = append(patches, operations.RemovePatchOperation("/stringData"))
+	}
+
+	return &operations.Result{
+		Allowed:  true,
+		PatchOps: patches,
+	}, nil
+}
+
+// mutateGitURL transforms a git url so it points to the git server in the Zarf state.
+// NOTE: Unlike Flux repositories, urls are checked on creates too, since Argo CD creates Applications from (already mutated) ApplicationSets.
+func mutateGitURL(state types.ZarfState, url string) string {
+	isPatched, err := utils.DoHostnamesMatch(state.GitServer.Address, url)
+	if err != nil {
+		// Argo CD also accepts scp-style ssh urls (e.g. git@github.com:org/repo.git), which Zarf can't mirror, so leave them be
+		message.Warnf("Unable to parse the git url, using the original url we have: %s", url)
+		return url
+	}
+	if isPatched {
+		return url
+	}
+
+	patchedURL, err := git.New(state.GitServer).TransformURL(url)
+	if err != nil {
+		message.Warnf("Unable to transform the git url, using the original url we have: %s", url)
+	}
+	message.Debugf("original git URL of (%s) got mutated to (%s)", url, patchedURL)
+
+	return patchedURL
+}
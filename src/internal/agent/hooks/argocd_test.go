@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks contains the mutation hooks for the Zarf agent.
+package hooks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/internal/agent/operations"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// testState is the Zarf state the hooks are tested against.
+var testState = types.ZarfState{
+	Distro: "k3d",
+	GitServer: types.GitServerInfo{
+		Address:        "http://zarf-gitea-http.zarf.svc.cluster.local:3000",
+		PushUsername:   "zarf-git-user",
+		PullUsername:   "zarf-git-read-user",
+		PullPassword:   "read-password",
+		InternalServer: true,
+	},
+	RegistryInfo: types.RegistryInfo{
+		Address:          "127.0.0.1:31999",
+		InternalRegistry: true,
+	},
+}
+
+// newAdmissionRequest returns an admission request for the given object.
+func newAdmissionRequest(t *testing.T, kind string, operation v1.Operation, object any) *v1.AdmissionRequest {
+	raw, err := json.Marshal(object)
+	require.NoError(t, err)
+
+	return &v1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Kind: kind},
+		Operation: operation,
+		Name:      "test",
+		Object:    runtime.RawExtension{Raw: raw},
+	}
+}
+
+// zarfGitURL returns the url a git url is mutated to on the git server of the test state.
+func zarfGitURL(t *testing.T, url string) string {
+	patchedURL, err := git.New(testState.GitServer).TransformURL(url)
+	require.NoError(t, err)
+	return patchedURL
+}
+
+func TestMutateArgoApplication(t *testing.T) {
+	setWatchedState(testState)
+
+	const (
+		repoURL  = "https://github.com/stefanprodan/podinfo.git"
+		otherURL = "https://github.com/defenseunicorns/zarf.git"
+		chartURL = "https://stefanprodan.github.io/podinfo"
+	)
+
+	tests := []struct {
+		name     string
+		kind     string
+		object   any
+		expected []operations.PatchOperation
+	}{
+		{
+			name: "source",
+			kind: "Application",
+			object: map[string]any{"spec": map[string]any{
+				"source": map[string]any{"repoURL": repoURL, "path": "kustomize"},
+			}},
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/source/repoURL", zarfGitURL(t, repoURL)),
+			},
+		},
+		{
+			name: "source already on the git server",
+			kind: "Application",
+			object: map[string]any{"spec": map[string]any{
+				"source": map[string]any{"repoURL": zarfGitURL(t, repoURL)},
+			}},
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/source/repoURL", zarfGitURL(t, repoURL)),
+			},
+		},
+		{
+			name: "helm chart source",
+			kind: "Application",
+			object: map[string]any{"spec": map[string]any{
+				"source": map[string]any{"repoURL": chartURL, "chart": "podinfo"},
+			}},
+		},
+		{
+			name: "sources",
+			kind: "Application",
+			object: map[string]any{"spec": map[string]any{
+				"sources": []map[string]any{
+					{"repoURL": repoURL},
+					{"repoURL": chartURL, "chart": "podinfo"},
+					{"repoURL": otherURL},
+				},
+			}},
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/sources/0/repoURL", zarfGitURL(t, repoURL)),
+				operations.ReplacePatchOperation("/spec/sources/2/repoURL", zarfGitURL(t, otherURL)),
+			},
+		},
+		{
+			name: "scp-style ssh source",
+			kind: "Application",
+			object: map[string]any{"spec": map[string]any{
+				"source": map[string]any{"repoURL": "git@github.com:stefanprodan/podinfo.git"},
+			}},
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/source/repoURL", "git@github.com:stefanprodan/podinfo.git"),
+			},
+		},
+		{
+			name: "ApplicationSet template",
+			kind: "ApplicationSet",
+			object: map[string]any{"spec": map[string]any{
+				"template": map[string]any{"spec": map[string]any{
+					"source":  map[string]any{"repoURL": repoURL},
+					"sources": []map[string]any{{"repoURL": otherURL}},
+				}},
+			}},
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/template/spec/source/repoURL", zarfGitURL(t, repoURL)),
+				operations.ReplacePatchOperation("/spec/template/spec/sources/0/repoURL", zarfGitURL(t, otherURL)),
+			},
+		},
+		{
+			name: "ApplicationSet git generator",
+			kind: "ApplicationSet",
+			object: map[string]any{"spec": map[string]any{
+				"generators": []map[string]any{
+					{"list": map[string]any{"elements": []any{}}},
+					{"git": map[string]any{"repoURL": otherURL, "revision": "HEAD"}},
+				},
+				"template": map[string]any{"spec": map[string]any{
+					"source": map[string]any{"repoURL": repoURL},
+				}},
+			}},
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/template/spec/source/repoURL", zarfGitURL(t, repoURL)),
+				operations.ReplacePatchOperation("/spec/generators/1/git/repoURL", zarfGitURL(t, otherURL)),
+			},
+		},
+		{
+			name: "ApplicationSet ignores the spec source",
+			kind: "ApplicationSet",
+			object: map[string]any{"spec": map[string]any{
+				"source": map[string]any{"repoURL": repoURL},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mutateArgoApplication(newAdmissionRequest(t, tt.kind, v1.Create, tt.object))
+			require.NoError(t, err)
+			require.True(t, result.Allowed)
+			require.Equal(t, tt.expected, result.PatchOps)
+		})
+	}
+}
+
+func TestMutateArgoRepository(t *testing.T) {
+	setWatchedState(testState)
+
+	const repoURL = "https://github.com/stefanprodan/podinfo.git"
+
+	// secret returns an Argo CD repository secret of the given type with the given data.
+	secret := func(secretType string, data map[string][]byte, stringData map[string]string) map[string]any {
+		return map[string]any{
+			"metadata":   map[string]any{"name": "podinfo", "labels": map[string]string{argoSecretTypeLabel: secretType}},
+			"data":       data,
+			"stringData": stringData,
+		}
+	}
+
+	// credentials returns the data the secret is patched to.
+	credentials := func(url string, data map[string]string) map[string][]byte {
+		patched := map[string][]byte{
+			"username": []byte(testState.GitServer.PullUsername),
+			"password": []byte(testState.GitServer.PullPassword),
+		}
+		if url != "" {
+			patched["url"] = []byte(url)
+		}
+		for key, value := range data {
+			patched[key] = []byte(value)
+		}
+		return patched
+	}
+
+	tests := []struct {
+		name     string
+		object   any
+		expected []operations.PatchOperation
+	}{
+		{
+			name:   "repository",
+			object: secret("repository", map[string][]byte{"url": []byte(repoURL), "password": []byte("upstream")}, nil),
+			expected: []operations.PatchOperation{
+				operations.AddPatchOperation("/data", credentials(zarfGitURL(t, repoURL), nil)),
+			},
+		},
+		{
+			name:   "git repository",
+			object: secret("repository", map[string][]byte{"url": []byte(repoURL), "type": []byte("git")}, nil),
+			expected: []operations.PatchOperation{
+				operations.AddPatchOperation("/data", credentials(zarfGitURL(t, repoURL), map[string]string{"type": "git"})),
+			},
+		},
+		{
+			name:   "repo-creds",
+			object: secret("repo-creds", map[string][]byte{"url": []byte("https://github.com/stefanprodan")}, nil),
+			expected: []operations.PatchOperation{
+				operations.AddPatchOperation("/data", credentials("http://zarf-gitea-http.zarf.svc.cluster.local:3000/zarf-git-user", nil)),
+			},
+		},
+		{
+			name:   "repository without a url",
+			object: secret("repository", nil, nil),
+			expected: []operations.PatchOperation{
+				operations.AddPatchOperation("/data", credentials("", nil)),
+			},
+		},
+		{
+			name:   "helm repository",
+			object: secret("repository", map[string][]byte{"url": []byte("https://stefanprodan.github.io/podinfo"), "type": []byte("helm")}, nil),
+		},
+		{
+			name:   "OCI helm repository",
+			object: secret("repository", nil, map[string]string{"url": "ghcr.io/stefanprodan/charts", "type": "helm", "enableOCI": "true"}),
+		},
+		{
+			name:   "OCI repository without a type",
+			object: secret("repository", map[string][]byte{"url": []byte("ghcr.io/stefanprodan/charts"), "enableOCI": []byte("true")}, nil),
+		},
+		{
+			name: "stringData is merged into the data",
+			object: secret("repository",
+				map[string][]byte{"url": []byte("https://github.com/defenseunicorns/zarf.git"), "project": []byte("default")},
+				map[string]string{"url": repoURL, "username": "upstream"},
+			),
+			expected: []operations.PatchOperation{
+				operations.AddPatchOperation("/data", credentials(zarfGitURL(t, repoURL), map[string]string{"project": "default"})),
+				operations.RemovePatchOperation("/stringData"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mutateArgoRepository(newAdmissionRequest(t, "Secret", v1.Create, tt.object))
+			require.NoError(t, err)
+			require.True(t, result.Allowed)
+			require.Equal(t, tt.expected, result.PatchOps)
+		})
+	}
+}
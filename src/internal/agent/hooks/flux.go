@@ -7,6 +7,7 @@ package hooks
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
@@ -16,9 +17,21 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/types"
 	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const zarfStatePath = "/etc/zarf-state/state"
+const (
+	zarfStatePath = "/etc/zarf-state/state"
+	ociURLPrefix  = "oci://"
+)
+
+// fluxInsecureVersions lists the API versions of each Flux source kind that have the spec.insecure field used to pull from
+// the in-cluster registry over plain HTTP. OCIRepository gained the field before Flux 2.0, but HelmRepository only gained it
+// in later v1beta2 releases, so only v1 is patched and older versions get a warning instead.
+var fluxInsecureVersions = map[string][]string{
+	"OCIRepository":  {"v1beta2", "v1"},
+	"HelmRepository": {"v1"},
+}
 
 // SecretRef contains the name used to reference a git repository secret.
 type SecretRef struct {
@@ -33,6 +46,24 @@ type GenericGitRepo struct {
 	}
 }
 
+// GenericOCIRepo contains the URL of a Flux HelmRepository or OCIRepository and the secret that corresponds to it.
+type GenericOCIRepo struct {
+	Spec struct {
+		URL       string    `json:"url"`
+		Type      string    `json:"type,omitempty"`
+		Insecure  bool      `json:"insecure,omitempty"`
+		SecretRef SecretRef `json:"secretRef,omitempty"`
+	}
+}
+
+// GenericBucket contains the endpoint of a Flux Bucket.
+type GenericBucket struct {
+	Spec struct {
+		Endpoint   string `json:"endpoint"`
+		BucketName string `json:"bucketName"`
+	}
+}
+
 // NewGitRepositoryMutationHook creates a new instance of the git repo mutation hook.
 func NewGitRepositoryMutationHook() operations.Hook {
 	message.Debug("hooks.NewGitRepositoryMutationHook()")
@@ -42,6 +73,33 @@ func NewGitRepositoryMutationHook() operations.Hook {
 	}
 }
 
+// NewHelmRepositoryMutationHook creates a new instance of the helm repo mutation hook.
+func NewHelmRepositoryMutationHook() operations.Hook {
+	message.Debug("hooks.NewHelmRepositoryMutationHook()")
+	return operations.Hook{
+		Create: mutateHelmRepo,
+		Update: mutateHelmRepo,
+	}
+}
+
+// NewOCIRepositoryMutationHook creates a new instance of the oci repo mutation hook.
+func NewOCIRepositoryMutationHook() operations.Hook {
+	message.Debug("hooks.NewOCIRepositoryMutationHook()")
+	return operations.Hook{
+		Create: mutateOCIRepo,
+		Update: mutateOCIRepo,
+	}
+}
+
+// NewBucketMutationHook creates a new instance of the bucket hook, which only warns that buckets are not mirrored.
+func NewBucketMutationHook() operations.Hook {
+	message.Debug("hooks.NewBucketMutationHook()")
+	return operations.Hook{
+		Create: warnBucket,
+		Update: warnBucket,
+	}
+}
+
 // mutateGitRepoCreate mutates the git repository url to point to the repository URL defined in the ZarfState.
 func mutateGitRepo(r *v1.AdmissionRequest) (result *operations.Result, err error) {
 
@@ -55,7 +113,7 @@ func mutateGitRepo(r *v1.AdmissionRequest) (result *operations.Result, err error
 	)
 
 	// Form the state.GitServer.Address from the state
-	if state, err = getStateFromAgentPod(zarfStatePath); err != nil {
+	if state, err = getState(); err != nil {
 		return nil, fmt.Errorf(lang.AgentErrGetState, err)
 	}
 
@@ -89,7 +147,7 @@ func mutateGitRepo(r *v1.AdmissionRequest) (result *operations.Result, err error
 	}
 
 	// Patch updates of the repo spec
-	patches = populatePatchOperations(patchedURL, src.Spec.SecretRef.Name)
+	patches = populatePatchOperations(patchedURL, src.Spec.SecretRef.Name, config.ZarfGitServerSecretName)
 
 	return &operations.Result{
 		Allowed:  true,
@@ -97,18 +155,140 @@ func mutateGitRepo(r *v1.AdmissionRequest) (result *operations.Result, err error
 	}, nil
 }
 
-// Patch updates of the repo spec.
-func populatePatchOperations(repoURL string, secretName string) []operations.PatchOperation {
+// Patch updates of the repo spec, pointing its secretRef at the given Zarf secret.
+func populatePatchOperations(repoURL string, secretName string, zarfSecretName string) []operations.PatchOperation {
 	var patches []operations.PatchOperation
 	patches = append(patches, operations.ReplacePatchOperation("/spec/url", repoURL))
 
 	// If a prior secret exists, replace it
 	if secretName != "" {
-		patches = append(patches, operations.ReplacePatchOperation("/spec/secretRef/name", config.ZarfGitServerSecretName))
+		patches = append(patches, operations.ReplacePatchOperation("/spec/secretRef/name", zarfSecretName))
 	} else {
 		// Otherwise, add the new secret
-		patches = append(patches, operations.AddPatchOperation("/spec/secretRef", SecretRef{Name: config.ZarfGitServerSecretName}))
+		patches = append(patches, operations.AddPatchOperation("/spec/secretRef", SecretRef{Name: zarfSecretName}))
 	}
 
 	return patches
 }
+
+// mutateHelmRepo mutates the url of an OCI helm repository to point to the registry defined in the ZarfState.
+// NOTE: Zarf does not serve an HTTP chart repository (index.yaml), so HTTP repositories are left as they are with a warning.
+func mutateHelmRepo(r *v1.AdmissionRequest) (result *operations.Result, err error) {
+	src := &GenericOCIRepo{}
+	if err = json.Unmarshal(r.Object.Raw, &src); err != nil {
+		return nil, fmt.Errorf(lang.ErrUnmarshal, err)
+	}
+
+	if src.Spec.Type != "oci" {
+		return warnFluxSource("HTTP HelmRepository", r.Name, src.Spec.URL), nil
+	}
+
+	// Flux appends the chart name to the repository url, so the url can't include the checksum Zarf adds to image names
+	return mutateOCIURL(r, src, utils.SwapHostWithoutChecksum)
+}
+
+// mutateOCIRepo mutates the url of an OCI repository to point to the registry defined in the ZarfState.
+func mutateOCIRepo(r *v1.AdmissionRequest) (result *operations.Result, err error) {
+	src := &GenericOCIRepo{}
+	if err = json.Unmarshal(r.Object.Raw, &src); err != nil {
+		return nil, fmt.Errorf(lang.ErrUnmarshal, err)
+	}
+
+	return mutateOCIURL(r, src, utils.SwapHost)
+}
+
+// mutateOCIURL swaps the host of an oci:// url for the registry defined in the ZarfState and points the secretRef at the Zarf registry secret.
+func mutateOCIURL(r *v1.AdmissionRequest, src *GenericOCIRepo, swapHost func(src string, targetHost string) (string, error)) (result *operations.Result, err error) {
+	var (
+		state     types.ZarfState
+		patches   []operations.PatchOperation
+		isPatched bool
+
+		isCreate = r.Operation == v1.Create
+		isUpdate = r.Operation == v1.Update
+	)
+
+	if state, err = getState(); err != nil {
+		return nil, fmt.Errorf(lang.AgentErrGetState, err)
+	}
+
+	// Flux pulls from inside the cluster, where the NodePort address on localhost used for images isn't reachable
+	registryAddress := config.GetRegistry(state)
+	if state.RegistryInfo.InternalRegistry {
+		registryAddress = strings.TrimPrefix(config.ZarfInClusterContainerRegistryURL, "http://")
+	}
+
+	message.Debugf("Using the url of (%s) to mutate the flux %s", registryAddress, r.Kind.Kind)
+
+	patchedURL := src.Spec.URL
+
+	// NOTE: We mutate on updates IF AND ONLY IF the hostname in the request is different than the hostname in the zarfState
+	if isUpdate {
+		isPatched, err = utils.DoHostnamesMatch(ociURLPrefix+registryAddress, src.Spec.URL)
+		if err != nil {
+			return nil, fmt.Errorf(lang.AgentErrHostnameMatch, err)
+		}
+	}
+
+	if isCreate || (isUpdate && !isPatched) {
+		ref, err := swapHost(strings.TrimPrefix(src.Spec.URL, ociURLPrefix), registryAddress)
+		if err != nil {
+			message.Warnf("Unable to transform the oci url, using the original url we have: %s", src.Spec.URL)
+		} else {
+			patchedURL = ociURLPrefix + ref
+		}
+		message.Debugf("original oci URL of (%s) got mutated to (%s)", src.Spec.URL, patchedURL)
+	}
+
+	patches = populatePatchOperations(patchedURL, src.Spec.SecretRef.Name, config.ZarfImagePullSecretName)
+
+	var warnings []string
+
+	// The in-cluster registry is served over plain HTTP
+	if state.RegistryInfo.InternalRegistry {
+		if hasFluxInsecureField(r.Kind) {
+			patches = append(patches, operations.AddPatchOperation("/spec/insecure", true))
+		} else if !src.Spec.Insecure {
+			warning := fmt.Sprintf(lang.AgentWarnFluxInsecure, r.Kind.Kind, r.Name, r.Kind.Version)
+			message.Warn(warning)
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return &operations.Result{
+		Allowed:  true,
+		PatchOps: patches,
+		Warnings: warnings,
+	}, nil
+}
+
+// hasFluxInsecureField returns whether the schema of the given Flux source kind and API version has the spec.insecure field.
+func hasFluxInsecureField(kind metav1.GroupVersionKind) bool {
+	for _, version := range fluxInsecureVersions[kind.Kind] {
+		if kind.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// warnBucket allows a Flux Bucket as it is, warning that Zarf does not serve object storage to mirror it to.
+func warnBucket(r *v1.AdmissionRequest) (result *operations.Result, err error) {
+	src := &GenericBucket{}
+	if err = json.Unmarshal(r.Object.Raw, &src); err != nil {
+		return nil, fmt.Errorf(lang.ErrUnmarshal, err)
+	}
+
+	return warnFluxSource("Bucket", r.Name, fmt.Sprintf("%s/%s", src.Spec.Endpoint, src.Spec.BucketName)), nil
+}
+
+// warnFluxSource allows a Flux source that Zarf can't mirror, warning the user (and the agent log) that it is left as it is.
+func warnFluxSource(kind, name, url string) *operations.Result {
+	warning := fmt.Sprintf(lang.AgentWarnFluxSource, kind, name, url)
+	message.Warn(warning)
+
+	return &operations.Result{
+		Allowed:  true,
+		Warnings: []string{warning},
+	}
+}
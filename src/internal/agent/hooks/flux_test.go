@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks contains the mutation hooks for the Zarf agent.
+package hooks
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/agent/operations"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMutateOCIURL(t *testing.T) {
+	const (
+		inClusterRegistry = "oci://zarf-docker-registry.zarf.svc.cluster.local:5000"
+		manifestsURL      = "oci://ghcr.io/stefanprodan/manifests/podinfo:6.3.3"
+		chartsURL         = "oci://ghcr.io/stefanprodan/charts"
+	)
+
+	externalState := testState
+	externalState.RegistryInfo = types.RegistryInfo{Address: "registry.example.com:5000"}
+
+	// newRepo returns a Flux repository with the given url and secret.
+	newRepo := func(url, secretName string) *GenericOCIRepo {
+		src := &GenericOCIRepo{}
+		src.Spec.URL = url
+		src.Spec.SecretRef.Name = secretName
+		return src
+	}
+
+	insecureRepo := newRepo(chartsURL, "")
+	insecureRepo.Spec.Insecure = true
+
+	addSecretRef := operations.AddPatchOperation("/spec/secretRef", SecretRef{Name: config.ZarfImagePullSecretName})
+	replaceSecretRef := operations.ReplacePatchOperation("/spec/secretRef/name", config.ZarfImagePullSecretName)
+	insecure := operations.AddPatchOperation("/spec/insecure", true)
+
+	tests := []struct {
+		name      string
+		state     types.ZarfState
+		kind      string
+		version   string
+		operation v1.Operation
+		src       *GenericOCIRepo
+		swapHost  func(src string, targetHost string) (string, error)
+		expected  []operations.PatchOperation
+		warnings  []string
+	}{
+		{
+			name:      "OCIRepository on the internal registry",
+			state:     testState,
+			kind:      "OCIRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       newRepo(manifestsURL, ""),
+			swapHost:  utils.SwapHost,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/manifests/podinfo-2823281104:6.3.3"),
+				addSecretRef,
+				insecure,
+			},
+		},
+		{
+			name:      "HelmRepository urls don't get a checksum",
+			state:     testState,
+			kind:      "HelmRepository",
+			version:   "v1",
+			operation: v1.Create,
+			src:       newRepo(chartsURL, ""),
+			swapHost:  utils.SwapHostWithoutChecksum,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/charts"),
+				addSecretRef,
+				insecure,
+			},
+		},
+		{
+			name:      "HelmRepository without the insecure field",
+			state:     testState,
+			kind:      "HelmRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       newRepo(chartsURL, ""),
+			swapHost:  utils.SwapHostWithoutChecksum,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/charts"),
+				addSecretRef,
+			},
+			warnings: []string{"Zarf Agent: the HelmRepository podinfo (v1beta2) has no spec.insecure field to pull from the Zarf registry over HTTP, use source.toolkit.fluxcd.io/v1 or set spec.insecure if your Flux version supports it"},
+		},
+		{
+			name:      "HelmRepository that already sets the insecure field",
+			state:     testState,
+			kind:      "HelmRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       insecureRepo,
+			swapHost:  utils.SwapHostWithoutChecksum,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/charts"),
+				addSecretRef,
+			},
+		},
+		{
+			name:      "HelmRepository on an external registry",
+			state:     externalState,
+			kind:      "HelmRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       newRepo(chartsURL, ""),
+			swapHost:  utils.SwapHostWithoutChecksum,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", "oci://registry.example.com:5000/stefanprodan/charts"),
+				addSecretRef,
+			},
+		},
+		{
+			name:      "existing secret is replaced",
+			state:     testState,
+			kind:      "OCIRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       newRepo(manifestsURL, "ghcr-auth"),
+			swapHost:  utils.SwapHost,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/manifests/podinfo-2823281104:6.3.3"),
+				replaceSecretRef,
+				insecure,
+			},
+		},
+		{
+			name:      "external registry",
+			state:     externalState,
+			kind:      "OCIRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       newRepo(manifestsURL, ""),
+			swapHost:  utils.SwapHost,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", "oci://registry.example.com:5000/stefanprodan/manifests/podinfo-2823281104:6.3.3"),
+				addSecretRef,
+			},
+		},
+		{
+			name:      "update of a url that was already mutated",
+			state:     testState,
+			kind:      "OCIRepository",
+			version:   "v1beta2",
+			operation: v1.Update,
+			src:       newRepo(inClusterRegistry+"/stefanprodan/manifests/podinfo-2823281104:6.3.3", config.ZarfImagePullSecretName),
+			swapHost:  utils.SwapHost,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/manifests/podinfo-2823281104:6.3.3"),
+				replaceSecretRef,
+				insecure,
+			},
+		},
+		{
+			name:      "update to a new url",
+			state:     testState,
+			kind:      "OCIRepository",
+			version:   "v1beta2",
+			operation: v1.Update,
+			src:       newRepo(manifestsURL, config.ZarfImagePullSecretName),
+			swapHost:  utils.SwapHost,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", inClusterRegistry+"/stefanprodan/manifests/podinfo-2823281104:6.3.3"),
+				replaceSecretRef,
+				insecure,
+			},
+		},
+		{
+			name:      "invalid url is left as it is",
+			state:     testState,
+			kind:      "OCIRepository",
+			version:   "v1beta2",
+			operation: v1.Create,
+			src:       newRepo("oci://ghcr.io/StefanProdan/manifests", ""),
+			swapHost:  utils.SwapHost,
+			expected: []operations.PatchOperation{
+				operations.ReplacePatchOperation("/spec/url", "oci://ghcr.io/StefanProdan/manifests"),
+				addSecretRef,
+				insecure,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setWatchedState(tt.state)
+
+			gvk := metav1.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: tt.version, Kind: tt.kind}
+			r := &v1.AdmissionRequest{Kind: gvk, Operation: tt.operation, Name: "podinfo"}
+			result, err := mutateOCIURL(r, tt.src, tt.swapHost)
+			require.NoError(t, err)
+			require.True(t, result.Allowed)
+			require.Equal(t, tt.expected, result.PatchOps)
+			require.Equal(t, tt.warnings, result.Warnings)
+		})
+	}
+}
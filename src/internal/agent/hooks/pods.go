@@ -7,14 +7,13 @@ package hooks
 import (
 	"encoding/json"
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
 	"github.com/defenseunicorns/zarf/src/internal/agent/operations"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
-	"github.com/defenseunicorns/zarf/src/types"
 	v1 "k8s.io/api/admission/v1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -60,16 +59,17 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 	zarfSecret := []corev1.LocalObjectReference{{Name: config.ZarfImagePullSecretName}}
 	patchOperations = append(patchOperations, operations.ReplacePatchOperation("/spec/imagePullSecrets", zarfSecret))
 
-	zarfState, err := getStateFromAgentPod(zarfStatePath)
+	zarfState, err := getState()
 	if err != nil {
 		return nil, fmt.Errorf(lang.AgentErrGetState, err)
 	}
 	containerRegistryURL := config.GetRegistry(zarfState)
+	pinDigests := zarfState.ImagePinning == config.ZarfImagePinningDigest
 
 	// update the image host for each init container
 	for idx, container := range pod.Spec.InitContainers {
 		path := fmt.Sprintf("/spec/initContainers/%d/image", idx)
-		replacement, err := utils.SwapHost(container.Image, containerRegistryURL)
+		replacement, err := swapImage(container.Image, containerRegistryURL, pinDigests)
 		if err != nil {
 			message.Warnf(lang.AgentErrImageSwap, container.Image)
 			continue // Continue, because we might as well attempt to mutate the other containers for this pod
@@ -80,7 +80,7 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 	// update the image host for each ephemeral container
 	for idx, container := range pod.Spec.EphemeralContainers {
 		path := fmt.Sprintf("/spec/ephemeralContainers/%d/image", idx)
-		replacement, err := utils.SwapHost(container.Image, containerRegistryURL)
+		replacement, err := swapImage(container.Image, containerRegistryURL, pinDigests)
 		if err != nil {
 			message.Warnf(lang.AgentErrImageSwap, container.Image)
 			continue // Continue, because we might as well attempt to mutate the other containers for this pod
@@ -91,7 +91,7 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 	// update the image host for each normal container
 	for idx, container := range pod.Spec.Containers {
 		path := fmt.Sprintf("/spec/containers/%d/image", idx)
-		replacement, err := utils.SwapHost(container.Image, containerRegistryURL)
+		replacement, err := swapImage(container.Image, containerRegistryURL, pinDigests)
 		if err != nil {
 			message.Warnf(lang.AgentErrImageSwap, container.Image)
 			continue // Continue, because we might as well attempt to mutate the other containers for this pod
@@ -102,22 +102,49 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 	// Add a label noting the zarf mutation
 	patchOperations = append(patchOperations, operations.ReplacePatchOperation("/metadata/labels/zarf-agent", "patched"))
 
+	// Check that the images exist in the Zarf registry if the image policy asks for it
+	var warnings []string
+	if zarfState.ImagePolicy == config.ZarfImagePolicyWarn || zarfState.ImagePolicy == config.ZarfImagePolicyEnforce {
+		var images []string
+		for _, container := range pod.Spec.InitContainers {
+			images = append(images, container.Image)
+		}
+		for _, container := range pod.Spec.EphemeralContainers {
+			images = append(images, container.Image)
+		}
+		for _, container := range pod.Spec.Containers {
+			images = append(images, container.Image)
+		}
+
+		missing, checkWarnings := checkImagePolicy(zarfState, images)
+		warnings = append(warnings, checkWarnings...)
+		if len(missing) > 0 {
+			if zarfState.ImagePolicy == config.ZarfImagePolicyEnforce {
+				return &operations.Result{Msg: fmt.Sprintf(lang.AgentErrImagePolicy, strings.Join(missing, "; "))}, nil
+			}
+			for _, msg := range missing {
+				warnings = append(warnings, fmt.Sprintf(lang.AgentWarnImagePolicy, msg))
+			}
+		}
+	}
+
 	return &operations.Result{
 		Allowed:  true,
 		PatchOps: patchOperations,
+		Warnings: warnings,
 	}, nil
 }
 
-// Reads the state json file that was mounted into the agent pods.
-func getStateFromAgentPod(zarfStatePath string) (types.ZarfState, error) {
-	zarfState := types.ZarfState{}
-
-	// Read the state file
-	stateFile, err := os.ReadFile(zarfStatePath)
-	if err != nil {
-		return zarfState, err
+// swapImage returns the image in the Zarf registry, pinned to the digest its package recorded if pinDigests is set.
+// Images without a recorded digest keep their tag.
+func swapImage(image string, registryURL string, pinDigests bool) (string, error) {
+	if pinDigests {
+		if ref, err := utils.NormalizeImageRef(image); err == nil {
+			if digest, ok := getImageDigest(ref); ok {
+				return utils.SwapHostWithDigest(image, registryURL, digest)
+			}
+		}
 	}
 
-	// Unmarshal the json file into a Go struct
-	return zarfState, json.Unmarshal(stateFile, &zarfState)
+	return utils.SwapHost(image, registryURL)
 }
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks contains the mutation hooks for the Zarf agent.
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// How long the result of looking up an image or the deployed packages is reused. Missing images are checked again
+// sooner so pods are admitted soon after the image is pushed.
+const (
+	imageFoundTTL    = 10 * time.Minute
+	imageMissingTTL  = 15 * time.Second
+	packageLookupTTL = time.Minute
+)
+
+// imageCheckBudget is how long the images of a pod are looked up in the registry for, which leaves room within the
+// webhook timeout (10s) to answer the admission request before the API server gives up and denies the pod.
+var imageCheckBudget = 5 * time.Second
+
+type imageCheck struct {
+	exists  bool
+	expires time.Time
+}
+
+var (
+	imageChecks    = map[string]imageCheck{}
+	imageChecksMtx sync.Mutex
+
+	deployedPackages        []types.DeployedPackage
+	deployedPackagesExpires time.Time
+	deployedPackagesMtx     sync.Mutex
+)
+
+// checkImagePolicy returns a message for each of the given (original) images of a pod that is missing from the Zarf registry,
+// and a warning for each image that can't be checked (e.g. the registry is unreachable or doesn't answer within the budget).
+// Those images are allowed, so the policy never blocks the cluster on its own.
+func checkImagePolicy(state types.ZarfState, images []string) (missing []string, warnings []string) {
+	// The agent runs in the cluster, where the NodePort address on localhost used for images isn't reachable
+	registryAddress := config.GetRegistry(state)
+	insecure := false
+	if state.RegistryInfo.InternalRegistry {
+		registryAddress = strings.TrimPrefix(config.ZarfInClusterContainerRegistryURL, "http://")
+		insecure = true
+	}
+
+	// Look up every image at once within a shared budget, so a slow registry can't hold up the admission request
+	ctx, cancel := context.WithTimeout(context.Background(), imageCheckBudget)
+	defer cancel()
+
+	options := config.GetCraneOptions(insecure)
+	options = append(options,
+		config.GetCraneAuthOption(state.RegistryInfo.PullUsername, state.RegistryInfo.PullPassword),
+		crane.WithContext(ctx),
+	)
+
+	exists := make([]bool, len(images))
+	errs := make([]error, len(images))
+	var wg sync.WaitGroup
+	for idx, image := range images {
+		target, err := utils.SwapHost(image, registryAddress)
+		if err != nil {
+			exists[idx] = true
+			continue
+		}
+
+		wg.Add(1)
+		go func(idx int, target string) {
+			defer wg.Done()
+			exists[idx], errs[idx] = imageExists(target, options)
+		}(idx, target)
+	}
+	wg.Wait()
+
+	for idx, image := range images {
+		if errs[idx] != nil {
+			warning := fmt.Sprintf(lang.AgentWarnImageCheck, image, errs[idx].Error())
+			message.Warn(warning)
+			warnings = append(warnings, warning)
+		} else if !exists[idx] {
+			missing = append(missing, fmt.Sprintf("the image %s is not in the Zarf registry, %s", image, describeImageSource(image)))
+		}
+	}
+
+	return missing, warnings
+}
+
+// imageExists checks (with caching) if the manifest of the given image exists in the registry.
+func imageExists(image string, options []crane.Option) (bool, error) {
+	imageChecksMtx.Lock()
+	check, ok := imageChecks[image]
+	imageChecksMtx.Unlock()
+	if ok && time.Now().Before(check.expires) {
+		return check.exists, nil
+	}
+
+	_, err := crane.Head(image, options...)
+	var terr *transport.Error
+	switch {
+	case err == nil:
+		check = imageCheck{exists: true, expires: time.Now().Add(imageFoundTTL)}
+	case errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound:
+		check = imageCheck{exists: false, expires: time.Now().Add(imageMissingTTL)}
+	default:
+		return false, err
+	}
+
+	imageChecksMtx.Lock()
+	// Evict the expired checks so the cache only holds the images of recently admitted pods
+	for cached, cachedCheck := range imageChecks {
+		if time.Now().After(cachedCheck.expires) {
+			delete(imageChecks, cached)
+		}
+	}
+	imageChecks[image] = check
+	imageChecksMtx.Unlock()
+
+	return check.exists, nil
+}
+
+// describeImageSource names the deployed package (and component) that includes the given image, if there is one.
+func describeImageSource(image string) string {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "and no deployed package includes it"
+	}
+
+	// Prefer the package with this exact image, but fall back to one with another tag of it (e.g. an older version of the package)
+	var fallback string
+	for _, pkg := range getDeployedPackages() {
+		for _, component := range pkg.Data.Components {
+			for _, pkgImage := range component.Images {
+				pkgRef, err := name.ParseReference(pkgImage)
+				if err != nil {
+					continue
+				}
+				source := fmt.Sprintf("component %s of package %s", component.Name, pkg.Name)
+				if pkgRef.Name() == ref.Name() {
+					return fmt.Sprintf("it should be provided by %s (redeploy the package to push it)", source)
+				}
+				if fallback == "" && pkgRef.Context().Name() == ref.Context().Name() {
+					fallback = fmt.Sprintf("%s provides %s instead (add it to the package that deploys this pod)", source, pkgImage)
+				}
+			}
+		}
+	}
+
+	if fallback != "" {
+		return fallback
+	}
+	return "and no deployed package includes it (add it to the images of the package that deploys this pod)"
+}
+
+// getDeployedPackages returns (with caching) the packages deployed to the cluster.
+func getDeployedPackages() []types.DeployedPackage {
+	deployedPackagesMtx.Lock()
+	defer deployedPackagesMtx.Unlock()
+
+	if time.Now().Before(deployedPackagesExpires) {
+		return deployedPackages
+	}
+
+	// The agent's service account can read the package secrets in the Zarf namespace
+	if c, _ := cluster.NewCluster(); c.Kube == nil {
+		message.Warnf("Unable to connect to the cluster to look up the deployed Zarf packages")
+	} else if packages, err := c.GetDeployedZarfPackages(); err != nil {
+		message.Warnf("Unable to look up the deployed Zarf packages: %s", err.Error())
+	} else {
+		deployedPackages = packages
+	}
+
+	deployedPackagesExpires = time.Now().Add(packageLookupTTL)
+	return deployedPackages
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks contains the mutation hooks for the Zarf agent.
+package hooks
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckImagePolicy(t *testing.T) {
+	// Don't look up the deployed packages in a cluster
+	deployedPackagesMtx.Lock()
+	deployedPackages, deployedPackagesExpires = nil, time.Now().Add(time.Hour)
+	deployedPackagesMtx.Unlock()
+
+	defer func(budget time.Duration) { imageCheckBudget = budget }(imageCheckBudget)
+	imageCheckBudget = 500 * time.Millisecond
+
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	registryAddress := strings.TrimPrefix(server.URL, "http://")
+
+	// Push an image under the name the agent looks it up by
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	target, err := utils.SwapHost("ghcr.io/stefanprodan/podinfo:6.3.3", registryAddress)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(img, target, crane.Insecure))
+
+	// A registry that never answers
+	hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hanging.Close()
+
+	tests := []struct {
+		name     string
+		registry string
+		images   []string
+		missing  []string
+		warnings []string
+	}{
+		{
+			name:     "image in the registry",
+			registry: server.URL,
+			images:   []string{"ghcr.io/stefanprodan/podinfo:6.3.3"},
+		},
+		{
+			name:     "image missing from the registry",
+			registry: server.URL,
+			images:   []string{"ghcr.io/stefanprodan/podinfo:6.3.3", "ghcr.io/stefanprodan/podinfo:6.3.4"},
+			missing:  []string{"the image ghcr.io/stefanprodan/podinfo:6.3.4 is not in the Zarf registry, and no deployed package includes it (add it to the images of the package that deploys this pod)"},
+		},
+		{
+			name:     "registry that doesn't answer within the budget",
+			registry: hanging.URL,
+			images:   []string{"ghcr.io/stefanprodan/podinfo:6.3.3", "docker.io/library/nginx:1.23"},
+			warnings: []string{
+				"Zarf Agent image policy: unable to check the Zarf registry for the image ghcr.io/stefanprodan/podinfo:6.3.3, so it is allowed",
+				"Zarf Agent image policy: unable to check the Zarf registry for the image docker.io/library/nginx:1.23, so it is allowed",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := types.ZarfState{RegistryInfo: types.RegistryInfo{Address: strings.TrimPrefix(tt.registry, "http://")}}
+
+			start := time.Now()
+			missing, warnings := checkImagePolicy(state, tt.images)
+
+			// The images are checked at once, so the whole check fits in the budget
+			require.Less(t, time.Since(start), 2*imageCheckBudget)
+			require.Equal(t, tt.missing, missing)
+			require.Len(t, warnings, len(tt.warnings))
+			for idx, warning := range tt.warnings {
+				require.Contains(t, warnings[idx], warning)
+			}
+		})
+	}
+}
+
+func TestImageExistsEvictsExpiredChecks(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	image := strings.TrimPrefix(server.URL, "http://") + "/library/nginx:1.23"
+
+	imageChecksMtx.Lock()
+	imageChecks["example.com/expired:1.0.0"] = imageCheck{exists: true, expires: time.Now().Add(-time.Second)}
+	imageChecks["example.com/current:1.0.0"] = imageCheck{exists: true, expires: time.Now().Add(time.Hour)}
+	imageChecksMtx.Unlock()
+
+	exists, err := imageExists(image, []crane.Option{crane.Insecure})
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	imageChecksMtx.Lock()
+	defer imageChecksMtx.Unlock()
+	require.NotContains(t, imageChecks, "example.com/expired:1.0.0")
+	require.Contains(t, imageChecks, "example.com/current:1.0.0")
+	require.Contains(t, imageChecks, image)
+}
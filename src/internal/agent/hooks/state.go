@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks contains the mutation hooks for the Zarf agent.
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/internal/agent/metrics"
+	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	secretSyncTimeout = 30 * time.Second
+
+	// stateFileTTL is how long the state read from the mounted file is used before reading it again, until the state secret is watched.
+	stateFileTTL = 10 * time.Second
+)
+
+var (
+	cachedState        *types.ZarfState
+	cachedStateReadAt  time.Time
+	cachedStateWatched bool
+	cachedStateMtx     sync.RWMutex
+
+	cachedImageDigests    = map[string]string{}
+	cachedImageDigestsMtx sync.RWMutex
+)
+
+// getState returns the Zarf state from the watched zarf-state secret. Until the secret is watched (e.g. if the watch failed), the
+// state is read from the file mounted into the agent pods, and read again once it is older than stateFileTTL to pick up changes.
+func getState() (types.ZarfState, error) {
+	cachedStateMtx.RLock()
+	state, watched, readAt := cachedState, cachedStateWatched, cachedStateReadAt
+	cachedStateMtx.RUnlock()
+	if state != nil && (watched || time.Since(readAt) < stateFileTTL) {
+		return *state, nil
+	}
+
+	loaded, err := getStateFromAgentPod(zarfStatePath)
+	if err != nil {
+		metrics.StateReloads.WithLabelValues("error").Inc()
+		return loaded, err
+	}
+
+	cachedStateMtx.Lock()
+	// Don't replace the state from the secret if the watch caught up while the file was read
+	if !cachedStateWatched {
+		cachedState = &loaded
+		cachedStateReadAt = time.Now()
+	}
+	cachedStateMtx.Unlock()
+	metrics.StateReloads.WithLabelValues("success").Inc()
+
+	return loaded, nil
+}
+
+// setWatchedState caches the state from the zarf-state secret, which is kept for as long as the agent runs.
+func setWatchedState(state types.ZarfState) {
+	cachedStateMtx.Lock()
+	cachedState = &state
+	cachedStateWatched = true
+	cachedStateMtx.Unlock()
+	metrics.StateReloads.WithLabelValues("success").Inc()
+}
+
+// WatchState keeps the cached Zarf state in sync with the zarf-state secret until the context is done, so changes (e.g. rotated
+// credentials or a new image policy) apply right away instead of once the kubelet refreshes the mounted file.
+func WatchState(ctx context.Context) error {
+	message.Debug("hooks.WatchState()")
+
+	return watchSecret(ctx, cluster.ZarfStateSecretName, func(secret *corev1.Secret) {
+		if secret == nil {
+			return
+		}
+
+		var state types.ZarfState
+		if err := json.Unmarshal(secret.Data[cluster.ZarfStateDataKey], &state); err != nil {
+			metrics.StateReloads.WithLabelValues("error").Inc()
+			message.Warnf("Unable to parse the updated Zarf state, keeping the current state: %s", err.Error())
+			return
+		}
+
+		message.Debugf("Reloaded the Zarf state from the %s secret (resource version %s)", secret.Name, secret.ResourceVersion)
+		setWatchedState(state)
+	})
+}
+
+// WatchImageDigests keeps the cached image digests in sync with the zarf-image-digests secret until the context is done.
+func WatchImageDigests(ctx context.Context) error {
+	message.Debug("hooks.WatchImageDigests()")
+
+	return watchSecret(ctx, cluster.ZarfImageDigestsSecretName, func(secret *corev1.Secret) {
+		digests := make(map[string]string)
+		if secret != nil {
+			var err error
+			if digests, err = cluster.ParseImageDigests(secret); err != nil {
+				message.Warnf("Unable to parse the updated image digests, keeping the current digests: %s", err.Error())
+				return
+			}
+		}
+
+		message.Debugf("Reloaded %d image digests", len(digests))
+		cachedImageDigestsMtx.Lock()
+		cachedImageDigests = digests
+		cachedImageDigestsMtx.Unlock()
+	})
+}
+
+// getImageDigest returns the digest recorded for the given (fully qualified) image reference, if there is one.
+func getImageDigest(ref string) (string, bool) {
+	cachedImageDigestsMtx.RLock()
+	defer cachedImageDigestsMtx.RUnlock()
+
+	digest, ok := cachedImageDigests[ref]
+	return digest, ok
+}
+
+// watchSecret calls onChange with the named secret in the Zarf namespace each time it is added or updated, and with nil
+// when it is deleted, until the context is done.
+func watchSecret(ctx context.Context, name string, onChange func(secret *corev1.Secret)) error {
+	c, _ := cluster.NewCluster()
+	if c.Kube == nil {
+		return fmt.Errorf("unable to connect to the cluster")
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(c.Kube.Clientset, 0,
+		informers.WithNamespace(cluster.ZarfNamespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}),
+	)
+
+	update := func(obj interface{}) {
+		if secret, ok := obj.(*corev1.Secret); ok {
+			onChange(secret)
+		}
+	}
+
+	informer := factory.Core().V1().Secrets().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    update,
+		UpdateFunc: func(_, obj interface{}) { update(obj) },
+		DeleteFunc: func(_ interface{}) { onChange(nil) },
+	})
+
+	factory.Start(ctx.Done())
+
+	// Don't hold up the webhook server if the secret can't be listed
+	syncCtx, cancel := context.WithTimeout(ctx, secretSyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		return fmt.Errorf("unable to sync the %s secret", name)
+	}
+
+	return nil
+}
+
+// Reads the state json file that was mounted into the agent pods.
+func getStateFromAgentPod(zarfStatePath string) (types.ZarfState, error) {
+	zarfState := types.ZarfState{}
+
+	// Read the state file
+	stateFile, err := os.ReadFile(zarfStatePath)
+	if err != nil {
+		return zarfState, err
+	}
+
+	// Unmarshal the json file into a Go struct
+	return zarfState, json.Unmarshal(stateFile, &zarfState)
+}
@@ -9,8 +9,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/agent/metrics"
 	"github.com/defenseunicorns/zarf/src/internal/agent/operations"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	v1 "k8s.io/api/admission/v1"
@@ -22,12 +27,30 @@ import (
 // admissionHandler represents the HTTP handler for an admission webhook.
 type admissionHandler struct {
 	decoder runtime.Decoder
+	audit   *json.Encoder
+}
+
+// auditEntry is the structured audit log line written for each admission response that changes or rejects a resource.
+type auditEntry struct {
+	Time      string                      `json:"time"`
+	Hook      string                      `json:"hook"`
+	UID       string                      `json:"uid"`
+	Kind      string                      `json:"kind"`
+	Namespace string                      `json:"namespace"`
+	Name      string                      `json:"name"`
+	Operation string                      `json:"operation"`
+	User      string                      `json:"user"`
+	Allowed   bool                        `json:"allowed"`
+	Message   string                      `json:"message,omitempty"`
+	Warnings  []string                    `json:"warnings,omitempty"`
+	Patch     []operations.PatchOperation `json:"patch,omitempty"`
 }
 
 // newAdmissionHandler returns an instance of AdmissionHandler.
 func newAdmissionHandler() *admissionHandler {
 	return &admissionHandler{
 		decoder: serializer.NewCodecFactory(runtime.NewScheme()).UniversalDeserializer(),
+		audit:   json.NewEncoder(os.Stdout),
 	}
 }
 
@@ -37,6 +60,8 @@ func (h *admissionHandler) Serve(hook operations.Hook) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		message.Debugf("http.Serve()(writer, %#v)", r.URL)
 
+		hookName := strings.TrimPrefix(r.URL.Path, "/mutate/")
+
 		w.Header().Set("Content-Type", "application/json")
 		if r.Method != http.MethodPost {
 			http.Error(w, lang.AgentErrInvalidMethod, http.StatusMethodNotAllowed)
@@ -50,23 +75,31 @@ func (h *admissionHandler) Serve(hook operations.Hook) http.HandlerFunc {
 
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
+			metrics.Errors.WithLabelValues(hookName, "bad_request").Inc()
 			http.Error(w, fmt.Sprintf(lang.AgentErrBadRequest, err), http.StatusBadRequest)
 			return
 		}
 
 		var review v1.AdmissionReview
 		if _, _, err := h.decoder.Decode(body, nil, &review); err != nil {
+			metrics.Errors.WithLabelValues(hookName, "decode").Inc()
 			http.Error(w, fmt.Sprintf(lang.AgentErrCouldNotDeserializeReq, err), http.StatusBadRequest)
 			return
 		}
 
 		if review.Request == nil {
+			metrics.Errors.WithLabelValues(hookName, "decode").Inc()
 			http.Error(w, lang.AgentErrNilReq, http.StatusBadRequest)
 			return
 		}
 
-		result, err := hook.Execute(review.Request)
+		request := review.Request
+		namespace, operation := request.Namespace, string(request.Operation)
+
+		result, err := hook.Execute(request)
 		if err != nil {
+			metrics.Errors.WithLabelValues(hookName, "hook").Inc()
+			metrics.AdmissionRequests.WithLabelValues(hookName, namespace, operation, metrics.OutcomeError).Inc()
 			message.Error(err, lang.AgentErrBindHandler)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
@@ -78,9 +111,10 @@ func (h *admissionHandler) Serve(hook operations.Hook) http.HandlerFunc {
 				Kind:       "AdmissionReview",
 			},
 			Response: &v1.AdmissionResponse{
-				UID:     review.Request.UID,
-				Allowed: result.Allowed,
-				Result:  &meta.Status{Message: result.Msg},
+				UID:      review.Request.UID,
+				Allowed:  result.Allowed,
+				Result:   &meta.Status{Message: result.Msg},
+				Warnings: result.Warnings,
 			},
 		}
 
@@ -89,8 +123,11 @@ func (h *admissionHandler) Serve(hook operations.Hook) http.HandlerFunc {
 			jsonPatchType := v1.PatchTypeJSONPatch
 			patchBytes, err := json.Marshal(result.PatchOps)
 			if err != nil {
+				metrics.Errors.WithLabelValues(hookName, "marshal").Inc()
+				metrics.AdmissionRequests.WithLabelValues(hookName, namespace, operation, metrics.OutcomeError).Inc()
 				message.Error(err, lang.AgentErrMarshallJSONPatch)
 				http.Error(w, lang.AgentErrMarshallJSONPatch, http.StatusInternalServerError)
+				return
 			}
 			admissionResponse.Response.Patch = patchBytes
 			admissionResponse.Response.PatchType = &jsonPatchType
@@ -98,13 +135,34 @@ func (h *admissionHandler) Serve(hook operations.Hook) http.HandlerFunc {
 
 		jsonResponse, err := json.Marshal(admissionResponse)
 		if err != nil {
+			metrics.Errors.WithLabelValues(hookName, "marshal").Inc()
+			metrics.AdmissionRequests.WithLabelValues(hookName, namespace, operation, metrics.OutcomeError).Inc()
 			message.Error(err, lang.AgentErrMarshalResponse)
 			http.Error(w, lang.AgentErrMarshalResponse, http.StatusInternalServerError)
 			return
 		}
 
-		message.Debug("PATCH: ", string(admissionResponse.Response.Patch))
-		message.Debug("RESPONSE: ", string(jsonResponse))
+		// Log the response with the values patched into secrets masked, the same as the audit log
+		debugResponse := *admissionResponse.Response
+		if len(result.PatchOps) > 0 {
+			debugResponse.Patch, _ = json.Marshal(maskPatch(request, result.PatchOps))
+		}
+		debugJSON, _ := json.Marshal(v1.AdmissionReview{TypeMeta: admissionResponse.TypeMeta, Response: &debugResponse})
+		message.Debug("PATCH: ", string(debugResponse.Patch))
+		message.Debug("RESPONSE: ", string(debugJSON))
+
+		outcome := metrics.OutcomeAllowed
+		if !result.Allowed {
+			outcome = metrics.OutcomeDenied
+		} else if len(result.Warnings) > 0 {
+			outcome = metrics.OutcomeWarned
+		}
+		metrics.AdmissionRequests.WithLabelValues(hookName, namespace, operation, outcome).Inc()
+		metrics.PatchOperations.WithLabelValues(hookName, namespace).Add(float64(len(result.PatchOps)))
+
+		if len(result.PatchOps) > 0 || outcome != metrics.OutcomeAllowed {
+			h.writeAudit(hookName, request, result)
+		}
 
 		message.Infof(lang.AgentInfoWebhookAllowed, r.URL.Path, review.Request.Operation, result.Allowed)
 		w.WriteHeader(http.StatusOK)
@@ -112,6 +170,44 @@ func (h *admissionHandler) Serve(hook operations.Hook) http.HandlerFunc {
 	}
 }
 
+// maskPatch returns the patch operations of an admission response with the values patched into secrets masked.
+func maskPatch(request *v1.AdmissionRequest, patchOps []operations.PatchOperation) []operations.PatchOperation {
+	if request.Kind.Kind != "Secret" {
+		return patchOps
+	}
+
+	patch := make([]operations.PatchOperation, len(patchOps))
+	for idx, op := range patchOps {
+		if op.Value != nil {
+			op.Value = config.ZarfMaskedValue
+		}
+		patch[idx] = op
+	}
+	return patch
+}
+
+// writeAudit writes the audit log line of an admission response, masking the patched values of secrets.
+func (h *admissionHandler) writeAudit(hookName string, request *v1.AdmissionRequest, result *operations.Result) {
+	entry := auditEntry{
+		Time:      time.Now().UTC().Format(time.RFC3339),
+		Hook:      hookName,
+		UID:       string(request.UID),
+		Kind:      request.Kind.Kind,
+		Namespace: request.Namespace,
+		Name:      request.Name,
+		Operation: string(request.Operation),
+		User:      request.UserInfo.Username,
+		Allowed:   result.Allowed,
+		Message:   result.Msg,
+		Warnings:  result.Warnings,
+		Patch:     maskPatch(request, result.PatchOps),
+	}
+
+	if err := h.audit.Encode(entry); err != nil {
+		message.Debugf("Unable to write the audit log for %s: %s", request.UID, err.Error())
+	}
+}
+
 func healthz() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
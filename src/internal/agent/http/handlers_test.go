@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package http provides a http server for the agent.
+package http
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/agent/operations"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/admission/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaskPatch(t *testing.T) {
+	patchOps := []operations.PatchOperation{
+		operations.AddPatchOperation("/data", map[string][]byte{"password": []byte("secret")}),
+		operations.RemovePatchOperation("/stringData"),
+	}
+
+	tests := []struct {
+		name     string
+		kind     string
+		expected []operations.PatchOperation
+	}{
+		{
+			name: "secret values are masked",
+			kind: "Secret",
+			expected: []operations.PatchOperation{
+				operations.AddPatchOperation("/data", config.ZarfMaskedValue),
+				operations.RemovePatchOperation("/stringData"),
+			},
+		},
+		{
+			name:     "other kinds are left as they are",
+			kind:     "Application",
+			expected: patchOps,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &v1.AdmissionRequest{Kind: meta.GroupVersionKind{Kind: tt.kind}}
+			require.Equal(t, tt.expected, maskPatch(request, patchOps))
+
+			// The response patch itself is never masked
+			require.Equal(t, []byte("secret"), patchOps[0].Value.(map[string][]byte)["password"])
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/defenseunicorns/zarf/src/internal/agent/hooks"
+	"github.com/defenseunicorns/zarf/src/internal/agent/metrics"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 )
 
@@ -19,13 +20,24 @@ func NewServer(port string) *http.Server {
 	// Instances hooks
 	podsMutation := hooks.NewPodMutationHook()
 	gitRepositoryMutation := hooks.NewGitRepositoryMutationHook()
+	helmRepositoryMutation := hooks.NewHelmRepositoryMutationHook()
+	ociRepositoryMutation := hooks.NewOCIRepositoryMutationHook()
+	bucketMutation := hooks.NewBucketMutationHook()
+	argoApplicationMutation := hooks.NewArgoApplicationMutationHook()
+	argoRepositoryMutation := hooks.NewArgoRepositoryMutationHook()
 
 	// Routers
 	ah := newAdmissionHandler()
 	mux := http.NewServeMux()
 	mux.Handle("/healthz", healthz())
+	mux.Handle("/metrics", metrics.Handler())
 	mux.Handle("/mutate/pod", ah.Serve(podsMutation))
 	mux.Handle("/mutate/flux-gitrepository", ah.Serve(gitRepositoryMutation))
+	mux.Handle("/mutate/flux-helmrepository", ah.Serve(helmRepositoryMutation))
+	mux.Handle("/mutate/flux-ocirepository", ah.Serve(ociRepositoryMutation))
+	mux.Handle("/mutate/flux-bucket", ah.Serve(bucketMutation))
+	mux.Handle("/mutate/argocd-application", ah.Serve(argoApplicationMutation))
+	mux.Handle("/mutate/argocd-repository", ah.Serve(argoRepositoryMutation))
 
 	return &http.Server{
 		Addr:    fmt.Sprintf(":%s", port),
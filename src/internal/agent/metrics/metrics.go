@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package metrics provides the Prometheus metrics of the agent.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Outcomes of an admission request.
+const (
+	OutcomeAllowed = "allowed"
+	OutcomeWarned  = "warned"
+	OutcomeDenied  = "denied"
+	OutcomeError   = "error"
+)
+
+var (
+	// AdmissionRequests counts the admission requests handled by each hook.
+	AdmissionRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "zarf_agent",
+		Name:      "admission_requests_total",
+		Help:      "Admission requests handled by the agent, by hook, namespace, operation and outcome (allowed, warned, denied or error).",
+	}, []string{"hook", "namespace", "operation", "outcome"})
+
+	// PatchOperations counts the JSON patch operations emitted by each hook.
+	PatchOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "zarf_agent",
+		Name:      "patch_operations_total",
+		Help:      "JSON patch operations emitted by the agent, by hook and namespace.",
+	}, []string{"hook", "namespace"})
+
+	// Errors counts the requests each hook failed to handle.
+	Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "zarf_agent",
+		Name:      "errors_total",
+		Help:      "Requests the agent failed to handle, by hook and reason.",
+	}, []string{"hook", "reason"})
+
+	// StateReloads counts the reloads of the Zarf state.
+	StateReloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "zarf_agent",
+		Name:      "state_reloads_total",
+		Help:      "Reloads of the Zarf state by the agent, by outcome (success or error).",
+	}, []string{"outcome"})
+)
+
+func init() {
+	prometheus.MustRegister(AdmissionRequests, PatchOperations, Errors, StateReloads)
+}
+
+// Handler returns the http.Handler that serves the metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -12,6 +12,7 @@ import (
 	"syscall"
 
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/agent/hooks"
 	agentHttp "github.com/defenseunicorns/zarf/src/internal/agent/http"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 )
@@ -30,6 +31,9 @@ const (
 func StartWebhook() {
 	message.Debug("agent.StartWebhook()")
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	server := agentHttp.NewServer(httpPort)
 	go func() {
 		if err := server.ListenAndServeTLS(tlsCert, tlsKey); err != nil && err != http.ErrServerClosed {
@@ -37,7 +41,20 @@ func StartWebhook() {
 		}
 	}()
 
-	message.Info(lang.AgentErrStart)
+	message.Infof(lang.AgentInfoPort, httpPort)
+
+	// Keep the Zarf state in sync with the cluster in the background, so admission requests are served while the secrets sync.
+	// Until then the hooks read the mounted state file (again every few seconds) and don't pin images to digests.
+	go func() {
+		if err := hooks.WatchState(ctx); err != nil {
+			message.Warnf(lang.AgentWarnWatchState, err.Error())
+		}
+	}()
+	go func() {
+		if err := hooks.WatchImageDigests(ctx); err != nil {
+			message.Warnf(lang.AgentWarnWatchDigests, err.Error())
+		}
+	}()
 
 	// listen shutdown signal
 	signalChan := make(chan os.Signal, 1)
@@ -5,6 +5,7 @@
 package cluster
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/defenseunicorns/zarf/src/config/lang"
@@ -30,14 +31,25 @@ func ReadState(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
-// UpdateState updates the Zarf state secret in the cluster.
-func UpdateState(w http.ResponseWriter, _ *http.Request) {
+// UpdateState updates the Zarf state secret in the cluster with the state in the request body.
+func UpdateState(w http.ResponseWriter, r *http.Request) {
 	message.Debug("state.Update()")
 
 	var data types.ZarfState
 
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		message.ErrorWebf(err, w, "Unable to decode the requested state")
+		return
+	}
+
+	// An empty state would orphan everything Zarf has deployed, so never save one
+	if data.Distro == "" {
+		message.ErrorWebf(nil, w, "Unable to save a state without a distro")
+		return
+	}
+
 	if err := cluster.NewClusterOrDie().SaveZarfState(data); err != nil {
-		message.ErrorWebf(err, w, lang.ErrLoadState)
+		message.ErrorWebf(err, w, "Unable to save the Zarf state to the cluster")
 	} else {
 		common.WriteJSONResponse(w, data, http.StatusCreated)
 	}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cluster contains Zarf-specific cluster management functions.
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/k8s"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/pki"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Names of the init package resources that hold Zarf generated credentials.
+const (
+	ZarfRegistryReleaseName  = "zarf-docker-registry"
+	ZarfGitServerReleaseName = "zarf-gitea"
+
+	agentDeploymentName = "agent-hook"
+	agentTLSSecretName  = "agent-hook-tls"
+	agentWebhookName    = "zarf"
+)
+
+// Services whose credentials can be rotated.
+const (
+	RegistryKey = "registry"
+	GitKey      = "git"
+	AgentKey    = "agent"
+)
+
+// RegenerateCredentials returns a copy of the given state with newly generated credentials for the given service.
+// Credentials for an external registry or git server are not generated by Zarf, so they are left as they are.
+func RegenerateCredentials(state types.ZarfState, service string) (types.ZarfState, error) {
+	switch service {
+	case RegistryKey:
+		if !state.RegistryInfo.InternalRegistry {
+			return state, fmt.Errorf("the registry at %s is not managed by Zarf", state.RegistryInfo.Address)
+		}
+		state.RegistryInfo.PushPassword = utils.RandomString(config.ZarfGeneratedPasswordLen)
+		state.RegistryInfo.PullPassword = utils.RandomString(config.ZarfGeneratedPasswordLen)
+
+	case GitKey:
+		if !state.GitServer.InternalServer {
+			return state, fmt.Errorf("the git server at %s is not managed by Zarf", state.GitServer.Address)
+		}
+		state.GitServer.PushPassword = utils.RandomString(config.ZarfGeneratedPasswordLen)
+		state.GitServer.PullPassword = utils.RandomString(config.ZarfGeneratedPasswordLen)
+
+	case AgentKey:
+		state.AgentTLS = pki.GeneratePKI(config.ZarfAgentHost)
+
+	default:
+		return state, fmt.Errorf("unknown service %s, must be one of %s, %s or %s", service, RegistryKey, GitKey, AgentKey)
+	}
+
+	return state, nil
+}
+
+// UpdateAgentTLS replaces the certificate served by the Zarf Agent and trusted by its webhook, then restarts the agent to use it.
+func (c *Cluster) UpdateAgentTLS(agentTLS k8s.GeneratedPKI) error {
+	message.Debugf("cluster.UpdateAgentTLS()")
+
+	// Keep the existing secret metadata so the helm release that owns it is left intact
+	secret, err := c.Kube.GetSecret(ZarfNamespace, agentTLSSecretName)
+	if err != nil {
+		return fmt.Errorf("unable to get the agent TLS secret: %w", err)
+	}
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       agentTLS.Cert,
+		corev1.TLSPrivateKeyKey: agentTLS.Key,
+	}
+	if err := c.Kube.CreateOrUpdateSecret(secret); err != nil {
+		return err
+	}
+
+	webhook, err := c.Kube.GetMutatingWebhookConfiguration(agentWebhookName)
+	if err != nil {
+		return fmt.Errorf("unable to get the agent webhook configuration: %w", err)
+	}
+	for idx := range webhook.Webhooks {
+		webhook.Webhooks[idx].ClientConfig.CABundle = agentTLS.CA
+	}
+	if _, err := c.Kube.UpdateMutatingWebhookConfiguration(webhook); err != nil {
+		return fmt.Errorf("unable to update the agent webhook configuration: %w", err)
+	}
+
+	if err := c.Kube.RestartDeployment(ZarfNamespace, agentDeploymentName); err != nil {
+		return fmt.Errorf("unable to restart the agent: %w", err)
+	}
+
+	return nil
+}
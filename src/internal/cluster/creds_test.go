@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cluster contains Zarf-specific cluster management functions.
+package cluster
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/pkg/k8s"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegenerateCredentials(t *testing.T) {
+	newState := func(internalRegistry, internalGitServer bool) types.ZarfState {
+		return types.ZarfState{
+			Distro: "k3d",
+			RegistryInfo: types.RegistryInfo{
+				Address:          "127.0.0.1:31999",
+				PushUsername:     "zarf-push",
+				PushPassword:     "registry-push",
+				PullUsername:     "zarf-pull",
+				PullPassword:     "registry-pull",
+				InternalRegistry: internalRegistry,
+			},
+			GitServer: types.GitServerInfo{
+				Address:        "http://zarf-gitea-http.zarf.svc.cluster.local:3000",
+				PushUsername:   "zarf-git-user",
+				PushPassword:   "git-push",
+				PullUsername:   "zarf-git-read-user",
+				PullPassword:   "git-pull",
+				InternalServer: internalGitServer,
+			},
+			AgentTLS: k8s.GeneratedPKI{CA: []byte("ca"), Cert: []byte("cert"), Key: []byte("key")},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		state    types.ZarfState
+		service  string
+		rotated  func(t *testing.T, old, new types.ZarfState)
+		expected func(state types.ZarfState) types.ZarfState
+		err      string
+	}{
+		{
+			name:    "internal registry",
+			state:   newState(true, true),
+			service: RegistryKey,
+			rotated: func(t *testing.T, old, new types.ZarfState) {
+				require.NotEqual(t, old.RegistryInfo.PushPassword, new.RegistryInfo.PushPassword)
+				require.NotEqual(t, old.RegistryInfo.PullPassword, new.RegistryInfo.PullPassword)
+			},
+			expected: func(state types.ZarfState) types.ZarfState {
+				state.RegistryInfo.PushPassword = ""
+				state.RegistryInfo.PullPassword = ""
+				return state
+			},
+		},
+		{
+			name:    "external registry",
+			state:   newState(false, true),
+			service: RegistryKey,
+			err:     "the registry at 127.0.0.1:31999 is not managed by Zarf",
+		},
+		{
+			name:    "internal git server",
+			state:   newState(true, true),
+			service: GitKey,
+			rotated: func(t *testing.T, old, new types.ZarfState) {
+				require.NotEqual(t, old.GitServer.PushPassword, new.GitServer.PushPassword)
+				require.NotEqual(t, old.GitServer.PullPassword, new.GitServer.PullPassword)
+			},
+			expected: func(state types.ZarfState) types.ZarfState {
+				state.GitServer.PushPassword = ""
+				state.GitServer.PullPassword = ""
+				return state
+			},
+		},
+		{
+			name:    "external git server",
+			state:   newState(true, false),
+			service: GitKey,
+			err:     "the git server at http://zarf-gitea-http.zarf.svc.cluster.local:3000 is not managed by Zarf",
+		},
+		{
+			name:    "agent",
+			state:   newState(false, false),
+			service: AgentKey,
+			rotated: func(t *testing.T, old, new types.ZarfState) {
+				require.NotEqual(t, old.AgentTLS.CA, new.AgentTLS.CA)
+				require.NotEqual(t, old.AgentTLS.Cert, new.AgentTLS.Cert)
+				require.NotEqual(t, old.AgentTLS.Key, new.AgentTLS.Key)
+			},
+			expected: func(state types.ZarfState) types.ZarfState {
+				state.AgentTLS = k8s.GeneratedPKI{}
+				return state
+			},
+		},
+		{
+			name:    "unknown service",
+			state:   newState(true, true),
+			service: "logging",
+			err:     "unknown service logging, must be one of registry, git or agent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := RegenerateCredentials(tt.state, tt.service)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				// The state is returned unchanged
+				require.Equal(t, tt.state, state)
+				return
+			}
+			require.NoError(t, err)
+
+			tt.rotated(t, tt.state, state)
+
+			// Nothing but the credentials of the service changes
+			require.Equal(t, tt.expected(tt.state), tt.expected(state))
+		})
+	}
+}
@@ -5,12 +5,19 @@
 package cluster
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
-	"sync"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/k8s"
@@ -18,23 +25,44 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/types"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/exec"
 )
 
-// HandleDataInjection waits for the target pod(s) to come up and inject the data into them
-// todo:  this currently requires kubectl but we should have enough k8s work to make this native now.
-func (c *Cluster) HandleDataInjection(wg *sync.WaitGroup, data types.ZarfDataInjection, componentPath types.ComponentPaths) {
-	message.Debugf("packager.handleDataInjections(%#v, %#v, %#v)", wg, data, componentPath)
-	defer wg.Done()
+const (
+	// defaultDataInjectionTimeout is how long a data injection can take if it does not set maxTotalSeconds.
+	defaultDataInjectionTimeout = 900
 
-	injectionCompletionMarker := filepath.Join(componentPath.DataInjections, config.GetDataInjectionMarker())
-	if err := utils.WriteFile(injectionCompletionMarker, []byte("🦄")); err != nil {
-		message.Errorf(err, "Unable to create the data injection completion marker")
-		return
+	dataInjectionContainer = "injector"
+	pvcMountPath           = "/zarf-pvc"
+)
+
+// HandleDataInjection waits for the target pod(s) to come up and injects the data into them over the Kubernetes API,
+// verifying the checksums of the injected files inside the target before marking the injection as complete.
+func (c *Cluster) HandleDataInjection(data types.ZarfDataInjection, componentPath types.ComponentPaths) error {
+	message.Debugf("cluster.HandleDataInjection(%#v, %#v)", data, componentPath)
+
+	if data.MaxTotalSeconds < 1 {
+		data.MaxTotalSeconds = defaultDataInjectionTimeout
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(data.MaxTotalSeconds)*time.Second)
+	defer cancel()
 
-	tarCompressFlag := ""
-	if data.Compress {
-		tarCompressFlag = "z"
+	source := filepath.Join(componentPath.DataInjections, filepath.Base(data.Target.Path))
+
+	if data.Target.PVC != "" {
+		if err := c.injectIntoPVC(ctx, data, source); err != nil {
+			return err
+		}
+
+		// Cleanup now to reduce disk pressure
+		_ = os.RemoveAll(source)
+
+		return nil
+	}
+
+	injectionCompletionMarker := filepath.Join(componentPath.DataInjections, config.GetDataInjectionMarker())
+	if err := utils.WriteFile(injectionCompletionMarker, []byte("🦄")); err != nil {
+		return fmt.Errorf("unable to create the data injection completion marker: %w", err)
 	}
 
 	// Pod filter to ensure we only use the current deployment's pods
@@ -43,81 +71,333 @@ func (c *Cluster) HandleDataInjection(wg *sync.WaitGroup, data types.ZarfDataInj
 		return strings.Contains(message.JSONValue(pod), config.GetDataInjectionMarker())
 	}
 
-iterator:
-	// The eternal loop because some data injections can take a very long time
+	target := k8s.PodLookup{
+		Namespace: data.Target.Namespace,
+		Selector:  data.Target.Selector,
+		Container: data.Target.Container,
+	}
+
+	var err error
 	for {
 		message.Debugf("Attempting to inject data into %s", data.Target)
-		source := filepath.Join(componentPath.DataInjections, filepath.Base(data.Target.Path))
-
-		target := k8s.PodLookup{
-			Namespace: data.Target.Namespace,
-			Selector:  data.Target.Selector,
-			Container: data.Target.Container,
-		}
 
 		// Wait until the pod we are injecting data into becomes available
-		pods := c.Kube.WaitForPodsAndContainers(target, podFilterByInitContainer)
+		pods := c.Kube.WaitForPodsAndContainers(ctx, target, podFilterByInitContainer)
 		if len(pods) < 1 {
-			continue
+			err = fmt.Errorf("no pods matching %s with a running container %s were found in namespace %s", data.Target.Selector, data.Target.Container, data.Target.Namespace)
+		} else {
+			err = c.injectIntoPods(ctx, data, pods, source, injectionCompletionMarker)
+		}
+
+		if err == nil {
+			break
 		}
+		message.Debugf("Unable to inject data into %s yet: %s", data.Target.Path, err.Error())
 
-		// Inject into all the pods
-		for _, pod := range pods {
-			kubectlExec := fmt.Sprintf("kubectl exec -i -n %s %s -c %s ", data.Target.Namespace, pod, data.Target.Container)
-			tarExec := fmt.Sprintf("tar c%s", tarCompressFlag)
-			untarExec := fmt.Sprintf("tar x%svf - -C %s", tarCompressFlag, data.Target.Path)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %d seconds injecting data into %s: %w", data.MaxTotalSeconds, data.Target.Path, err)
+		case <-time.After(time.Second):
+		}
+	}
+
+	// Do not look for a specific container after injection in case they are running an init container
+	podOnlyTarget := k8s.PodLookup{
+		Namespace: data.Target.Namespace,
+		Selector:  data.Target.Selector,
+	}
+
+	// Block one final time to make sure at least one pod has come up and injected the data
+	// Using only the pod as the final selector because we don't know what the container name will be
+	// Still using the init container filter to make sure we have the right running pod
+	_ = c.Kube.WaitForPodsAndContainers(ctx, podOnlyTarget, podFilterByInitContainer)
+
+	// Cleanup now to reduce disk pressure
+	_ = os.RemoveAll(source)
+
+	return nil
+}
+
+// injectIntoPVC injects the data into a PersistentVolumeClaim through a temporary helper pod that mounts the claim and runs the
+// seed registry image from the Zarf registry (which includes tar and sha256sum), so the image of the app using the claim does not matter.
+func (c *Cluster) injectIntoPVC(ctx context.Context, data types.ZarfDataInjection, source string) error {
+	namespace := data.Target.Namespace
+
+	// Wait for the claim in case an earlier component just created it
+	for {
+		_, err := c.Kube.GetPersistentVolumeClaim(namespace, data.Target.PVC)
+		if err == nil {
+			break
+		}
+		message.Debugf("Unable to find the PersistentVolumeClaim %s yet: %s", data.Target.PVC, err.Error())
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the PersistentVolumeClaim %s in namespace %s: %w", data.Target.PVC, namespace, err)
+		case <-time.After(time.Second):
+		}
+	}
+
+	state, err := c.LoadZarfState()
+	if err != nil {
+		return fmt.Errorf("unable to load the Zarf state: %w", err)
+	}
+	image := fmt.Sprintf("%s/library/%s:%s", config.GetRegistry(state), config.ZarfSeedImage, config.ZarfSeedTag)
+
+	// The helper pod pulls its image from the Zarf registry
+	pullSecret, err := c.GenerateRegistryPullCreds(namespace, config.ZarfImagePullSecretName)
+	if err != nil {
+		return fmt.Errorf("unable to generate the registry pull secret for namespace %s: %w", namespace, err)
+	}
+	if err := c.Kube.CreateOrUpdateSecret(pullSecret); err != nil {
+		return fmt.Errorf("unable to create the registry pull secret for namespace %s: %w", namespace, err)
+	}
+
+	pod, err := c.Kube.CreatePod(c.buildDataInjectionPod(data, image))
+	if err != nil {
+		return fmt.Errorf("unable to create the data injection pod for the PersistentVolumeClaim %s: %w", data.Target.PVC, err)
+	}
+	defer func() {
+		if err := c.Kube.DeletePod(namespace, pod.Name); err != nil {
+			message.Warnf("Unable to remove the data injection pod %s: %s", pod.Name, err.Error())
+		}
+	}()
+
+	// Injections run alongside each other and the rest of the component, so only log progress instead of using a spinner
+	message.Debugf("Waiting for the data injection pod for the PersistentVolumeClaim %s", data.Target.PVC)
+
+	for {
+		current, err := c.Kube.GetPod(namespace, pod.Name)
+		if err == nil && current.Status.Phase == corev1.PodRunning {
+			break
+		}
+		if err == nil && (current.Status.Phase == corev1.PodFailed || current.Status.Phase == corev1.PodSucceeded) {
+			return fmt.Errorf("the data injection pod %s stopped unexpectedly", pod.Name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %d seconds waiting for the data injection pod %s to start", data.MaxTotalSeconds, pod.Name)
+		case <-time.After(time.Second):
+		}
+	}
+
+	// Inject into the claim as mounted in the helper pod, without the completion marker since no pod waits on it
+	data.Target.Container = dataInjectionContainer
+	data.Target.Path = path.Join(pvcMountPath, data.Target.Path)
+
+	return c.injectIntoPods(ctx, data, []string{pod.Name}, source, "")
+}
+
+// buildDataInjectionPod builds the helper pod that mounts a PersistentVolumeClaim to inject data into it.
+func (c *Cluster) buildDataInjectionPod(data types.ZarfDataInjection, image string) *corev1.Pod {
+	pod := c.Kube.GeneratePod("", data.Target.Namespace)
+	pod.GenerateName = "zarf-data-injection-"
+
+	// The image already points at the Zarf registry, so the agent does not need to mutate it
+	pod.Labels = map[string]string{agentLabel: "ignore"}
+	for key, value := range c.Kube.Labels {
+		pod.Labels[key] = value
+	}
 
-			// Must create the target directory before trying to change to it for untar
-			mkdirExec := fmt.Sprintf("%s -- mkdir -p %s", kubectlExec, data.Target.Path)
-			_, _, err := utils.ExecCommandWithContext(context.TODO(), true, "sh", "-c", mkdirExec)
+	// Do not try to restart the pod as it will be deleted once the injection is done
+	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+
+	// sleep ignores SIGTERM as PID 1, so do not wait for it to stop when the pod is deleted
+	gracePeriod := int64(0)
+	pod.Spec.TerminationGracePeriodSeconds = &gracePeriod
+
+	pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: config.ZarfImagePullSecretName}}
+
+	pod.Spec.Containers = []corev1.Container{
+		{
+			Name:            dataInjectionContainer,
+			Image:           image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+
+			// Stay up for the exec streams, but never outlive the injection
+			Command: []string{"sleep", strconv.Itoa(data.MaxTotalSeconds)},
+
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "data",
+					MountPath: pvcMountPath,
+				},
+			},
+		},
+	}
+
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "data",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: data.Target.PVC,
+				},
+			},
+		},
+	}
+
+	return pod
+}
+
+// injectIntoPods streams the data into every given pod, verifies it and then leaves the completion marker (if one is given) for the pods to track.
+func (c *Cluster) injectIntoPods(ctx context.Context, data types.ZarfDataInjection, pods []string, source, marker string) error {
+	checksums, size, err := injectionChecksums(source, data.Target.Path)
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods {
+		run := func(command []string, stdin io.Reader) (string, error) {
+			var output bytes.Buffer
+			err := c.Kube.ExecInPod(ctx, data.Target.Namespace, pod, data.Target.Container, command, stdin, &output, &output)
+			return strings.TrimSpace(output.String()), err
+		}
+
+		// Must create the target directory before extracting into it
+		if output, err := run([]string{"mkdir", "-p", data.Target.Path}, nil); err != nil {
+			return fmt.Errorf("unable to create the directory %s in pod %s: %s: %w", data.Target.Path, pod, output, err)
+		}
+
+		// Do the actual data injection (injections run concurrently, so they do not draw progress bars over each other)
+		message.Debugf("Injecting %s of data into %s in pod %s", utils.ByteFormat(float64(size), 2), data.Target.Path, pod)
+		tarStream := streamTar(source, "", data.Compress)
+		output, err := run(untarCommand(data), tarStream)
+		// Stop the tar stream in case the exec did not read all of it
+		_ = tarStream.Close()
+		if err != nil {
+			return fmt.Errorf("unable to copy the data into pod %s: %s: %w", pod, output, err)
+		}
+
+		// Make sure every file made it into the target intact
+		if output, err := run([]string{"sha256sum", "-c", "-"}, strings.NewReader(checksums)); err != nil {
+			var exitErr exec.ExitError
+			if errors.As(err, &exitErr) && exitErr.ExitStatus() == 1 {
+				return fmt.Errorf("the data injected into pod %s does not match the package: %s", pod, output)
+			}
+			message.Warnf("Unable to verify the data injected into %s in pod %s (is sha256sum available in the container?)", data.Target.Path, pod)
+			message.Debug(output, err)
+		}
+
+		// Leave a marker in the target container for pods to track the sync action
+		if marker != "" {
+			markerStream := streamTar(marker, filepath.Base(marker), data.Compress)
+			output, err := run(untarCommand(data), markerStream)
+			_ = markerStream.Close()
+			if err != nil {
+				return fmt.Errorf("unable to save the zarf sync completion file into pod %s: %s: %w", pod, output, err)
+			}
+		}
+
+		message.SuccessF("Injected data into %s in pod %s (%s)", data.Target.Path, pod, utils.ByteFormat(float64(size), 2))
+	}
+
+	return nil
+}
+
+func untarCommand(data types.ZarfDataInjection) []string {
+	if data.Compress {
+		return []string{"tar", "xzf", "-", "-C", data.Target.Path}
+	}
+	return []string{"tar", "xf", "-", "-C", data.Target.Path}
+}
+
+// streamTar returns a reader that streams a tar of the given file or directory as it is written. The contents of a directory are
+// added at the root of the tar, and a file is added with the given name. Closing the reader stops the stream.
+func streamTar(source, name string, compress bool) io.ReadCloser {
+	reader, writer := io.Pipe()
+
+	go func() {
+		var out io.WriteCloser = writer
+		if compress {
+			out = gzip.NewWriter(writer)
+		}
+		tarWriter := tar.NewWriter(out)
+
+		err := filepath.Walk(source, func(file string, info os.FileInfo, err error) error {
 			if err != nil {
-				message.Warnf("Unable to create the data injection target directory %s in pod %s", data.Target.Path, pod)
-				continue iterator
+				return err
 			}
 
-			cpPodExec := fmt.Sprintf("%s -C %s . | %s -- %s",
-				tarExec,
-				source,
-				kubectlExec,
-				untarExec,
-			)
+			rel, err := filepath.Rel(source, file)
+			if err != nil {
+				return err
+			}
+			if rel == "." {
+				if info.IsDir() {
+					return nil
+				}
+				rel = name
+			}
 
-			// Do the actual data injection
-			_, _, err = utils.ExecCommandWithContext(context.TODO(), true, "sh", "-c", cpPodExec)
+			link := ""
+			if info.Mode()&os.ModeSymlink != 0 {
+				if link, err = os.Readlink(file); err != nil {
+					return err
+				}
+			}
+
+			header, err := tar.FileInfoHeader(info, link)
 			if err != nil {
-				message.Warnf("Error copying data into the pod %#v: %#v\n", pod, err)
-				continue iterator
+				return err
+			}
+			header.Name = filepath.ToSlash(rel)
+			if err := tarWriter.WriteHeader(header); err != nil {
+				return err
 			}
-			// Leave a marker in the target container for pods to track the sync action
-			cpPodExec = fmt.Sprintf("%s -C %s %s | %s -- %s",
-				tarExec,
-				componentPath.DataInjections,
-				config.GetDataInjectionMarker(),
-				kubectlExec,
-				untarExec,
-			)
-			_, _, err = utils.ExecCommandWithContext(context.TODO(), true, "sh", "-c", cpPodExec)
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+
+			f, err := os.Open(file)
 			if err != nil {
-				message.Warnf("Error saving the zarf sync completion file after injection into pod %#v\n", pod)
-				continue iterator
+				return err
 			}
+			defer f.Close()
+
+			_, err = io.Copy(tarWriter, f)
+			return err
+		})
+
+		if err == nil {
+			err = tarWriter.Close()
+		}
+		if err == nil && compress {
+			err = out.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	return reader
+}
+
+// injectionChecksums returns the sha256sum -c input for the files of the given source as they will be in the target path,
+// along with their total size.
+func injectionChecksums(source, targetPath string) (string, int64, error) {
+	var checksums strings.Builder
+	var size int64
+
+	err := filepath.Walk(source, func(file string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
 		}
 
-		// Do not look for a specific container after injection in case they are running an init container
-		podOnlyTarget := k8s.PodLookup{
-			Namespace: data.Target.Namespace,
-			Selector:  data.Target.Selector,
+		rel, err := filepath.Rel(source, file)
+		if err != nil {
+			return err
 		}
 
-		// Block one final time to make sure at least one pod has come up and injected the data
-		// Using only the pod as the final selector because we don't know what the container name will be
-		// Still using the init container filter to make sure we have the right running pod
-		_ = c.Kube.WaitForPodsAndContainers(podOnlyTarget, podFilterByInitContainer)
+		sum, err := utils.GetSha256Sum(file)
+		if err != nil {
+			return err
+		}
 
-		// Cleanup now to reduce disk pressure
-		_ = os.RemoveAll(source)
+		checksums.WriteString(fmt.Sprintf("%s  %s\n", sum, path.Join(targetPath, filepath.ToSlash(rel))))
+		size += info.Size()
+		return nil
+	})
 
-		// Return to stop the loop
-		return
-	}
+	return checksums.String(), size, err
 }
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cluster contains Zarf-specific cluster management functions.
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// The image digests secret keeps, for each package (by package name), a map of the fully qualified reference of each image it
+// deployed to its digest, so the Zarf Agent can pin pods to the content the package pushed.
+const (
+	ZarfImageDigestsSecretName = "zarf-image-digests"
+)
+
+// RecordImageDigests saves the digest of each of the given images of a package to the zarf/zarf-image-digests secret. Images
+// without a digest are removed from the digests of the package, since their tag may now point to other content. The digests
+// recorded by other packages are left as they are.
+func (c *Cluster) RecordImageDigests(packageName string, images []string, digests map[string]string) error {
+	message.Debugf("cluster.RecordImageDigests(%s, %#v, %#v)", packageName, images, digests)
+
+	if len(images) == 0 {
+		return nil
+	}
+
+	return c.updateImageDigests(packageName, func(recorded map[string]string) {
+		for _, image := range images {
+			ref, err := utils.NormalizeImageRef(image)
+			if err != nil {
+				message.Warnf("Unable to record the digest of the image %s: %s", image, err.Error())
+				continue
+			}
+
+			if digest, ok := digests[image]; ok {
+				recorded[ref] = digest
+			} else {
+				delete(recorded, ref)
+			}
+		}
+	})
+}
+
+// RemoveImageDigests removes the digests of the given images from the digests a package recorded.
+func (c *Cluster) RemoveImageDigests(packageName string, images []string) error {
+	message.Debugf("cluster.RemoveImageDigests(%s, %#v)", packageName, images)
+
+	if len(images) == 0 {
+		return nil
+	}
+
+	return c.updateImageDigests(packageName, func(recorded map[string]string) {
+		for _, image := range images {
+			if ref, err := utils.NormalizeImageRef(image); err == nil {
+				delete(recorded, ref)
+			}
+		}
+	})
+}
+
+// DeletePackageImageDigests removes all of the digests a package recorded.
+func (c *Cluster) DeletePackageImageDigests(packageName string) error {
+	message.Debugf("cluster.DeletePackageImageDigests(%s)", packageName)
+
+	return c.updateImageDigests(packageName, func(recorded map[string]string) {
+		for ref := range recorded {
+			delete(recorded, ref)
+		}
+	})
+}
+
+// updateImageDigests applies the given update to the digests recorded by a package and saves them, dropping the package from
+// the secret once it has no digests left.
+func (c *Cluster) updateImageDigests(packageName string, update func(recorded map[string]string)) error {
+	secret, err := c.Kube.GetSecret(ZarfNamespace, ZarfImageDigestsSecretName)
+	if errors.IsNotFound(err) {
+		secret = c.Kube.GenerateSecret(ZarfNamespace, ZarfImageDigestsSecretName, corev1.SecretTypeOpaque)
+	} else if err != nil {
+		return fmt.Errorf("unable to get the image digests secret: %w", err)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+
+	recorded := make(map[string]string)
+	if data, ok := secret.Data[packageName]; ok {
+		if err := json.Unmarshal(data, &recorded); err != nil {
+			message.Warnf("Replacing the unreadable image digests of the package %s: %s", packageName, err.Error())
+			recorded = make(map[string]string)
+		}
+	}
+
+	update(recorded)
+
+	if len(recorded) == 0 {
+		if _, ok := secret.Data[packageName]; !ok {
+			return nil
+		}
+		delete(secret.Data, packageName)
+	} else {
+		data, err := json.Marshal(recorded)
+		if err != nil {
+			return fmt.Errorf("unable to json-encode the image digests: %w", err)
+		}
+		secret.Data[packageName] = data
+	}
+
+	if err := c.Kube.CreateOrUpdateSecret(secret); err != nil {
+		return fmt.Errorf("unable to save the image digests secret: %w", err)
+	}
+
+	return nil
+}
+
+// ParseImageDigests returns the digests (by fully qualified image reference) recorded by all of the packages in the image digests
+// secret. An image that packages recorded different digests for is left out, since Zarf can't tell which one its pods expect.
+func ParseImageDigests(secret *corev1.Secret) (map[string]string, error) {
+	digests := make(map[string]string)
+	conflicts := make(map[string]bool)
+
+	for packageName, data := range secret.Data {
+		recorded := make(map[string]string)
+		if err := json.Unmarshal(data, &recorded); err != nil {
+			return digests, fmt.Errorf("unable to parse the image digests of the package %s: %w", packageName, err)
+		}
+
+		for ref, digest := range recorded {
+			if existing, ok := digests[ref]; ok && existing != digest {
+				conflicts[ref] = true
+			}
+			digests[ref] = digest
+		}
+	}
+
+	for ref := range conflicts {
+		message.Debugf("Not pinning the image %s since packages recorded different digests for it", ref)
+		delete(digests, ref)
+	}
+
+	return digests, nil
+}
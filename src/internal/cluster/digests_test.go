@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cluster contains Zarf-specific cluster management functions.
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseImageDigests(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]string
+		expected map[string]string
+		err      bool
+	}{
+		{
+			name:     "no packages",
+			expected: map[string]string{},
+		},
+		{
+			name: "merges packages",
+			data: map[string]string{
+				"app":   `{"docker.io/library/nginx:1.23":"sha256:aaa"}`,
+				"tools": `{"docker.io/library/alpine:3.15":"sha256:bbb"}`,
+			},
+			expected: map[string]string{
+				"docker.io/library/nginx:1.23":  "sha256:aaa",
+				"docker.io/library/alpine:3.15": "sha256:bbb",
+			},
+		},
+		{
+			name: "packages agree",
+			data: map[string]string{
+				"app":   `{"docker.io/library/nginx:1.23":"sha256:aaa"}`,
+				"other": `{"docker.io/library/nginx:1.23":"sha256:aaa"}`,
+			},
+			expected: map[string]string{
+				"docker.io/library/nginx:1.23": "sha256:aaa",
+			},
+		},
+		{
+			name: "packages disagree",
+			data: map[string]string{
+				"app":   `{"docker.io/library/nginx:1.23":"sha256:aaa","docker.io/library/alpine:3.15":"sha256:bbb"}`,
+				"other": `{"docker.io/library/nginx:1.23":"sha256:ccc"}`,
+			},
+			expected: map[string]string{
+				"docker.io/library/alpine:3.15": "sha256:bbb",
+			},
+		},
+		{
+			name: "unreadable package",
+			data: map[string]string{"app": `not json`},
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: map[string][]byte{}}
+			for packageName, data := range tt.data {
+				secret.Data[packageName] = []byte(data)
+			}
+
+			digests, err := ParseImageDigests(secret)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, digests)
+		})
+	}
+}
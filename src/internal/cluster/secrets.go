@@ -8,11 +8,13 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/types"
 )
 
 // DockerConfig contains the authentication information from the machine's docker config.
@@ -58,6 +60,14 @@ func (c *Cluster) GenerateRegistryPullCreds(namespace, name string) (*corev1.Sec
 		},
 	}
 
+	// Workloads that pull from inside the cluster (e.g. Flux OCI sources) reach the internal registry by its service address instead
+	if zarfState.RegistryInfo.InternalRegistry {
+		serviceAddress := strings.TrimPrefix(config.ZarfInClusterContainerRegistryURL, "http://")
+		dockerConfigJSON.Auths[serviceAddress] = DockerConfigEntryWithAuth{
+			Auth: authEncodedValue,
+		}
+	}
+
 	// Convert to JSON
 	dockerConfigData, err := json.Marshal(dockerConfigJSON)
 	if err != nil {
@@ -69,3 +79,56 @@ func (c *Cluster) GenerateRegistryPullCreds(namespace, name string) (*corev1.Sec
 
 	return secretDockerConfig, nil
 }
+
+// GenerateGitServerPullCreds generates a secret containing the git server read-only credentials.
+func (c *Cluster) GenerateGitServerPullCreds(namespace, name string, gitServer types.GitServerInfo) *corev1.Secret {
+	message.Debugf("k8s.GenerateGitServerPullCreds(%s, %s)", namespace, name)
+
+	gitServerSecret := c.Kube.GenerateSecret(namespace, name, corev1.SecretTypeOpaque)
+	gitServerSecret.StringData = map[string]string{
+		"username": gitServer.PullUsername,
+		"password": gitServer.PullPassword,
+	}
+
+	return gitServerSecret
+}
+
+// UpdateZarfManagedSecrets refreshes the registry and git server pull secrets in every namespace managed by Zarf
+// (labeled as such or already holding a Zarf pull secret) with the credentials in the given (already saved) state.
+func (c *Cluster) UpdateZarfManagedSecrets(state types.ZarfState) error {
+	message.Debugf("cluster.UpdateZarfManagedSecrets()")
+
+	spinner := message.NewProgressSpinner("Updating the Zarf pull secrets in existing namespaces")
+	defer spinner.Stop()
+
+	namespaces, err := c.Kube.GetNamespaces()
+	if err != nil {
+		return fmt.Errorf("unable to get k8s namespaces: %w", err)
+	}
+
+	for _, namespace := range namespaces.Items {
+		currentSecret, err := c.Kube.GetSecret(namespace.Name, config.ZarfImagePullSecretName)
+		hasSecret := err == nil && currentSecret.Labels[config.ZarfManagedByLabel] == "zarf"
+		if namespace.Labels[config.ZarfManagedByLabel] != "zarf" && !hasSecret {
+			continue
+		}
+
+		spinner.Updatef("Updating the Zarf pull secrets for namespace %s", namespace.Name)
+
+		registrySecret, err := c.GenerateRegistryPullCreds(namespace.Name, config.ZarfImagePullSecretName)
+		if err != nil {
+			return fmt.Errorf("unable to generate the registry pull secret for namespace %s: %w", namespace.Name, err)
+		}
+		if err := c.Kube.CreateOrUpdateSecret(registrySecret); err != nil {
+			return fmt.Errorf("unable to update the registry pull secret for namespace %s: %w", namespace.Name, err)
+		}
+
+		gitServerSecret := c.GenerateGitServerPullCreds(namespace.Name, config.ZarfGitServerSecretName, state.GitServer)
+		if err := c.Kube.CreateOrUpdateSecret(gitServerSecret); err != nil {
+			return fmt.Errorf("unable to update the git server secret for namespace %s: %w", namespace.Name, err)
+		}
+	}
+
+	spinner.Success()
+	return nil
+}
@@ -112,6 +112,14 @@ func (c *Cluster) InitZarfState(tempPath types.TempPaths, initOptions types.Zarf
 		state.StorageClass = initOptions.StorageClass
 	}
 
+	// Keep the existing image policy and pinning unless new ones are given
+	if initOptions.ImagePolicy != "" {
+		state.ImagePolicy = initOptions.ImagePolicy
+	}
+	if initOptions.ImagePinning != "" {
+		state.ImagePinning = initOptions.ImagePinning
+	}
+
 	state.GitServer = c.fillInEmptyGitServerValues(initOptions.GitServer)
 	state.RegistryInfo = c.fillInEmptyContainerRegistryValues(initOptions.RegistryInfo)
 
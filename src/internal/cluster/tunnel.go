@@ -7,6 +7,7 @@ package cluster
 // Forked from https://github.com/gruntwork-io/terratest/blob/v0.38.8/modules/k8s/tunnel.go
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -245,7 +246,7 @@ func (tunnel *Tunnel) Connect(target string, blocking bool) error {
 		defer tunnel.Close()
 
 		// Keep this open until an interrupt signal is received.
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 		go func() {
 			<-c
@@ -450,7 +451,7 @@ func (tunnel *Tunnel) getAttachablePodForService() (string, error) {
 	}
 	selectorLabelsOfPods := makeLabels(service.Spec.Selector)
 
-	servicePods := tunnel.kube.WaitForPodsAndContainers(k8s.PodLookup{
+	servicePods := tunnel.kube.WaitForPodsAndContainers(context.TODO(), k8s.PodLookup{
 		Namespace: tunnel.namespace,
 		Selector:  selectorLabelsOfPods,
 	}, nil)
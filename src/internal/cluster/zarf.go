@@ -7,6 +7,9 @@ package cluster
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
@@ -15,6 +18,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// maxPackageGenerations is the number of deployed package generations kept for rollbacks.
+const maxPackageGenerations = 10
+
 // GetDeployedZarfPackages gets metadata information about packages that have been deployed to the cluster.
 // We determine what packages have been deployed to the cluster by looking for specific secrets in the Zarf namespace.
 func (c *Cluster) GetDeployedZarfPackages() ([]types.DeployedPackage, error) {
@@ -42,6 +48,17 @@ func (c *Cluster) GetDeployedZarfPackages() ([]types.DeployedPackage, error) {
 	return deployedPackages, nil
 }
 
+// GetDeployedPackage gets the metadata information about a single package that has been deployed to the cluster.
+func (c *Cluster) GetDeployedPackage(packageName string) (deployedPackage types.DeployedPackage, err error) {
+	secret, err := c.Kube.GetSecret(ZarfNamespace, config.ZarfPackagePrefix+packageName)
+	if err != nil {
+		return deployedPackage, err
+	}
+
+	err = json.Unmarshal(secret.Data["data"], &deployedPackage)
+	return deployedPackage, err
+}
+
 // StripZarfLabelsAndSecretsFromNamespaces removes metadata and secrets from existing namespaces no longer manged by Zarf.
 func (c *Cluster) StripZarfLabelsAndSecretsFromNamespaces() {
 	spinner := message.NewProgressSpinner("Removing zarf metadata & secrets from existing namespaces not managed by Zarf")
@@ -80,21 +97,204 @@ func (c *Cluster) StripZarfLabelsAndSecretsFromNamespaces() {
 	spinner.Success()
 }
 
-// RecordPackageDeployment saves metadata about a package that has been deployed to the cluster.
-func (c *Cluster) RecordPackageDeployment(pkg types.ZarfPackage, components []types.DeployedComponent) {
+// RecordPackageDeployment saves metadata about a package that has been deployed to the cluster,
+// adding a new generation to the package history so it can be rolled back to later.
+func (c *Cluster) RecordPackageDeployment(pkg types.ZarfPackage, components []types.DeployedComponent, variables map[string]string) error {
 	// Generate a secret that describes the package that is being deployed
 	packageName := pkg.Metadata.Name
 	deployedPackageSecret := c.Kube.GenerateSecret(ZarfNamespace, config.ZarfPackagePrefix+packageName, corev1.SecretTypeOpaque)
 	deployedPackageSecret.Labels[ZarfPackageInfoLabel] = packageName
 
-	stateData, _ := json.Marshal(types.DeployedPackage{
+	// Carry the history forward from the previous deployment (if there is one)
+	previous, _ := c.GetDeployedPackage(packageName)
+	generation := previous.Generation + 1
+
+	// Only the helm releases are needed to roll back, so keep the history small
+	var generationComponents []types.DeployedComponent
+	for _, component := range components {
+		generationComponents = append(generationComponents, types.DeployedComponent{
+			Name:            component.Name,
+			InstalledCharts: component.InstalledCharts,
+		})
+	}
+
+	// The history is shown with the package, so leave out the values of sensitive variables
+	maskedVariables := make(map[string]string, len(variables))
+	for key, value := range variables {
+		maskedVariables[key] = value
+	}
+	for _, variable := range pkg.Variables {
+		if _, ok := maskedVariables[variable.Name]; ok && variable.Sensitive {
+			maskedVariables[variable.Name] = config.ZarfMaskedValue
+		}
+	}
+
+	// Save the package definition of this generation on its own so the history stays small
+	if err := c.recordPackageGeneration(pkg, generation); err != nil {
+		return fmt.Errorf("unable to save generation %d of package %s: %w", generation, packageName, err)
+	}
+
+	history := append(previous.History, types.DeployedPackageGeneration{
+		Generation: generation,
+		Version:    pkg.Metadata.Version,
+		Timestamp:  time.Now().Format(time.RFC1123Z),
+		Variables:  maskedVariables,
+		Components: generationComponents,
+	})
+
+	if len(history) > maxPackageGenerations {
+		for _, trimmed := range history[:len(history)-maxPackageGenerations] {
+			if err := c.deletePackageGeneration(packageName, trimmed.Generation); err != nil {
+				message.Debugf("Unable to delete generation %d of package %s: %s", trimmed.Generation, packageName, err.Error())
+			}
+		}
+		history = history[len(history)-maxPackageGenerations:]
+	}
+
+	stateData, err := json.Marshal(types.DeployedPackage{
 		Name:               packageName,
 		CLIVersion:         config.CLIVersion,
 		Data:               pkg,
 		DeployedComponents: components,
+		Generation:         generation,
+		History:            history,
 	})
+	if err != nil {
+		return err
+	}
 
 	deployedPackageSecret.Data = map[string][]byte{"data": stateData}
 
-	c.Kube.CreateOrUpdateSecret(deployedPackageSecret)
+	return c.Kube.CreateOrUpdateSecret(deployedPackageSecret)
+}
+
+// GetPackageGeneration returns the package definition recorded for a generation of a deployed package.
+func (c *Cluster) GetPackageGeneration(packageName string, generation int) (pkg types.ZarfPackage, err error) {
+	secret, err := c.Kube.GetSecret(ZarfNamespace, packageGenerationSecretName(packageName, generation))
+	if err != nil {
+		return pkg, err
+	}
+
+	err = json.Unmarshal(secret.Data["data"], &pkg)
+	return pkg, err
+}
+
+// DeletePackageGenerations removes the package definitions recorded for the history of a package that is no longer deployed.
+func (c *Cluster) DeletePackageGenerations(deployedPackage types.DeployedPackage) error {
+	var errs []string
+	for _, entry := range deployedPackage.History {
+		if err := c.deletePackageGeneration(deployedPackage.Name, entry.Generation); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to delete the package generations: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// recordPackageGeneration saves the package definition of a generation in its own secret.
+func (c *Cluster) recordPackageGeneration(pkg types.ZarfPackage, generation int) error {
+	generationSecret := c.Kube.GenerateSecret(ZarfNamespace, packageGenerationSecretName(pkg.Metadata.Name, generation), corev1.SecretTypeOpaque)
+
+	pkgData, err := json.Marshal(pkg)
+	if err != nil {
+		return err
+	}
+
+	generationSecret.Data = map[string][]byte{"data": pkgData}
+
+	return c.Kube.CreateOrUpdateSecret(generationSecret)
+}
+
+// deletePackageGeneration removes the package definition saved for a generation.
+func (c *Cluster) deletePackageGeneration(packageName string, generation int) error {
+	generationSecret := c.Kube.GenerateSecret(ZarfNamespace, packageGenerationSecretName(packageName, generation), corev1.SecretTypeOpaque)
+	return c.Kube.DeleteSecret(generationSecret)
+}
+
+// packageGenerationSecretName returns the name of the secret holding the package definition of a generation.
+func packageGenerationSecretName(packageName string, generation int) string {
+	return fmt.Sprintf("%s%s-%d", config.ZarfGenerationPrefix, packageName, generation)
+}
+
+// RecordDeployProgress saves the components finished so far by a deployment that is still in progress.
+// The finished components are merged into the deployed components so they can still be removed if the deployment fails.
+func (c *Cluster) RecordDeployProgress(pkg types.ZarfPackage, checksum string, components []types.DeployedComponent) error {
+	packageName := pkg.Metadata.Name
+	deployedPackageSecret := c.Kube.GenerateSecret(ZarfNamespace, config.ZarfPackagePrefix+packageName, corev1.SecretTypeOpaque)
+	deployedPackageSecret.Labels[ZarfPackageInfoLabel] = packageName
+
+	// Keep the history and any components from the previous deployment (if there is one)
+	deployedPackage, _ := c.GetDeployedPackage(packageName)
+	deployedPackage.Name = packageName
+	deployedPackage.CLIVersion = config.CLIVersion
+	deployedPackage.Data = pkg
+
+	progress := types.DeployProgress{
+		Checksum:  checksum,
+		Timestamp: time.Now().Format(time.RFC1123Z),
+	}
+
+	for _, component := range components {
+		progress.Components = append(progress.Components, component.Name)
+
+		replaced := false
+		for idx, deployedComponent := range deployedPackage.DeployedComponents {
+			if deployedComponent.Name == component.Name {
+				deployedPackage.DeployedComponents[idx] = component
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			deployedPackage.DeployedComponents = append(deployedPackage.DeployedComponents, component)
+		}
+	}
+
+	deployedPackage.Progress = &progress
+
+	stateData, err := json.Marshal(deployedPackage)
+	if err != nil {
+		return err
+	}
+
+	deployedPackageSecret.Data = map[string][]byte{"data": stateData}
+
+	return c.Kube.CreateOrUpdateSecret(deployedPackageSecret)
+}
+
+// RecordPackageVariables saves the variable values of a deployed package in their own secret so that the next
+// deployment of the package can reuse them.
+func (c *Cluster) RecordPackageVariables(packageName string, variables map[string]string) error {
+	variablesSecret := c.Kube.GenerateSecret(ZarfNamespace, config.ZarfVariablesPrefix+packageName, corev1.SecretTypeOpaque)
+
+	for key, value := range variables {
+		variablesSecret.Data[key] = []byte(value)
+	}
+
+	return c.Kube.CreateOrUpdateSecret(variablesSecret)
+}
+
+// GetPackageVariables returns the variable values saved by the last deployment of a package.
+func (c *Cluster) GetPackageVariables(packageName string) (map[string]string, error) {
+	variables := make(map[string]string)
+
+	secret, err := c.Kube.GetSecret(ZarfNamespace, config.ZarfVariablesPrefix+packageName)
+	if err != nil {
+		return variables, err
+	}
+
+	for key, value := range secret.Data {
+		variables[key] = string(value)
+	}
+
+	return variables, nil
+}
+
+// DeletePackageVariables removes the saved variable values of a package that is no longer deployed.
+func (c *Cluster) DeletePackageVariables(packageName string) error {
+	variablesSecret := c.Kube.GenerateSecret(ZarfNamespace, config.ZarfVariablesPrefix+packageName, corev1.SecretTypeOpaque)
+	return c.Kube.DeleteSecret(variablesSecret)
 }
@@ -102,6 +102,30 @@ func (g *Git) CreateReadOnlyUser() error {
 	return err
 }
 
+// UpdateGitUser uses the Gitea API to set the password of a Zarf user, authenticating as the push user with the given password.
+func (g *Git) UpdateGitUser(pushPassword string, username string, password string) error {
+	message.Debugf("git.UpdateGitUser(%s)", username)
+
+	// Establish a git tunnel to reach the Gitea API
+	tunnel, err := cluster.NewZarfTunnel()
+	if err != nil {
+		return err
+	}
+	tunnel.Connect(cluster.ZarfGit, false)
+	defer tunnel.Close()
+
+	updateUserBody := map[string]interface{}{
+		"login_name": username,
+		"password":   password,
+	}
+	updateUserData, _ := json.Marshal(updateUserBody)
+	updateUserEndpoint := fmt.Sprintf("http://%s/api/v1/admin/users/%s", tunnel.Endpoint(), username)
+	updateUserRequest, _ := netHttp.NewRequest("PATCH", updateUserEndpoint, bytes.NewBuffer(updateUserData))
+	out, err := g.DoHTTPThings(updateUserRequest, g.Server.PushUsername, pushPassword)
+	message.Debugf("PATCH %s:\n%s", updateUserEndpoint, string(out))
+	return err
+}
+
 func (g *Git) addReadOnlyUserToRepo(tunnelURL, repo string) error {
 	message.Debugf("git.addReadOnlyUserToRepo()")
 
@@ -147,3 +171,22 @@ func (g *Git) DoHTTPThings(request *netHttp.Request, username, secret string) ([
 
 	return responseBody, nil
 }
+
+// DeleteRepo uses the Gitea API to remove the given repository from the Zarf git server.
+func (g *Git) DeleteRepo(repoName string) error {
+	message.Debugf("git.DeleteRepo(%s)", repoName)
+
+	// Establish a git tunnel to reach the Gitea API
+	tunnel, err := cluster.NewZarfTunnel()
+	if err != nil {
+		return err
+	}
+	tunnel.Connect(cluster.ZarfGit, false)
+	defer tunnel.Close()
+
+	deleteRepoEndpoint := fmt.Sprintf("http://%s/api/v1/repos/%s/%s", tunnel.Endpoint(), g.Server.PushUsername, repoName)
+	deleteRepoRequest, _ := netHttp.NewRequest("DELETE", deleteRepoEndpoint, nil)
+	out, err := g.DoHTTPThings(deleteRepoRequest, g.Server.PushUsername, g.Server.PushPassword)
+	message.Debugf("DELETE %s:\n%s", deleteRepoEndpoint, string(out))
+	return err
+}
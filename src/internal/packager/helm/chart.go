@@ -25,8 +25,8 @@ import (
 // Set the default helm client timeout to 15 minutes
 const defaultClientTimeout = 15 * time.Minute
 
-// InstallOrUpgradeChart performs a helm install of the given chart.
-func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, string, error) {
+// InstallOrUpgradeChart performs a helm install of the given chart and returns the installed release.
+func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, types.InstalledChart, error) {
 	fromMessage := h.Chart.URL
 	if fromMessage == "" {
 		fromMessage = "Zarf-generated helm chart"
@@ -46,21 +46,24 @@ func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, string, error) {
 		h.ReleaseName = h.Chart.Name
 	}
 
-	// Do not wait for the chart to be ready if data injections are present
-	if len(h.Component.DataInjections) > 0 {
-		spinner.Updatef("Data injections detected, not waiting for chart to be ready")
-		h.Chart.NoWait = true
+	// Do not wait for the chart to be ready if data injections into pods are present (injections into PVCs are already done)
+	for _, data := range h.Component.DataInjections {
+		if data.Target.PVC == "" {
+			spinner.Updatef("Data injections detected, not waiting for chart to be ready")
+			h.Chart.NoWait = true
+			break
+		}
 	}
 
 	// Setup K8s connection
 	err := h.createActionConfig(h.Chart.Namespace, spinner)
 	if err != nil {
-		return nil, "", fmt.Errorf("unable to initialize the K8s client: %w", err)
+		return nil, types.InstalledChart{}, fmt.Errorf("unable to initialize the K8s client: %w", err)
 	}
 
 	postRender, err := h.newRenderer()
 	if err != nil {
-		return nil, "", fmt.Errorf("unable to create helm renderer: %w", err)
+		return nil, types.InstalledChart{}, fmt.Errorf("unable to create helm renderer: %w", err)
 	}
 
 	attempt := 0
@@ -75,12 +78,12 @@ func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, string, error) {
 			// On total failure try to rollback or uninstall
 			if histClient.Version > 1 {
 				spinner.Updatef("Performing chart rollback")
-				_ = h.rollbackChart(h.ReleaseName)
+				_ = h.rollbackChart(h.ReleaseName, 0)
 			} else {
 				spinner.Updatef("Performing chart uninstall")
 				_, _ = h.uninstallChart(h.ReleaseName)
 			}
-			return nil, "", fmt.Errorf("unable to install/upgrade chart after 3 attempts")
+			return nil, types.InstalledChart{}, fmt.Errorf("unable to install/upgrade chart after 3 attempts")
 		}
 
 		spinner.Updatef("Checking for existing helm deployment")
@@ -100,7 +103,7 @@ func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, string, error) {
 
 		default:
 			// 😭 things aren't working
-			return nil, "", fmt.Errorf("unable to verify the chart installation status: %w", histErr)
+			return nil, types.InstalledChart{}, fmt.Errorf("unable to verify the chart installation status: %w", histErr)
 		}
 
 		if err != nil {
@@ -115,8 +118,14 @@ func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, string, error) {
 
 	}
 
+	installedChart := types.InstalledChart{
+		Namespace: h.Chart.Namespace,
+		ChartName: h.ReleaseName,
+		Revision:  output.Version,
+	}
+
 	// return any collected connect strings for zarf connect
-	return postRender.connectStrings, h.ReleaseName, nil
+	return postRender.connectStrings, installedChart, nil
 }
 
 // TemplateChart generates a helm template from a given chart.
@@ -167,8 +176,17 @@ func (h *Helm) TemplateChart() (string, error) {
 }
 
 // GenerateChart generates a helm chart for a given Zarf manifest.
-func (h *Helm) GenerateChart(manifest types.ZarfManifest) (types.ConnectStrings, string, error) {
-	message.Debugf("helm.GenerateChart(%#v)", manifest)
+func (h *Helm) GenerateChart(manifest types.ZarfManifest) (types.ConnectStrings, types.InstalledChart, error) {
+	if err := h.generateChart(manifest); err != nil {
+		return nil, types.InstalledChart{}, err
+	}
+
+	return h.InstallOrUpgradeChart()
+}
+
+// generateChart builds an in-memory helm chart from the manifest files and sets it as the chart override.
+func (h *Helm) generateChart(manifest types.ZarfManifest) error {
+	message.Debugf("helm.generateChart(%#v)", manifest)
 	spinner := message.NewProgressSpinner("Starting helm chart generation %s", manifest.Name)
 	defer spinner.Stop()
 
@@ -193,7 +211,7 @@ func (h *Helm) GenerateChart(manifest types.ZarfManifest) (types.ConnectStrings,
 		manifest := fmt.Sprintf("%s/%s", h.BasePath, file)
 		data, err := os.ReadFile(manifest)
 		if err != nil {
-			return nil, "", fmt.Errorf("unable to read manifest file %s: %w", manifest, err)
+			return fmt.Errorf("unable to read manifest file %s: %w", manifest, err)
 		}
 		tmpChart.Templates = append(tmpChart.Templates, &chart.File{Name: manifest, Data: data})
 	}
@@ -214,7 +232,7 @@ func (h *Helm) GenerateChart(manifest types.ZarfManifest) (types.ConnectStrings,
 
 	spinner.Success()
 
-	return h.InstallOrUpgradeChart()
+	return nil
 }
 
 // RemoveChart removes a chart from the cluster.
@@ -286,9 +304,11 @@ func (h *Helm) upgradeChart(postRender *renderer) (*release.Release, error) {
 	return client.Run(h.ReleaseName, loadedChart, chartValues)
 }
 
-func (h *Helm) rollbackChart(name string) error {
-	message.Debugf("helm.rollbackChart(%s)", name)
+// rollbackChart rolls the release back to the given revision, or the previous one if revision is 0.
+func (h *Helm) rollbackChart(name string, revision int) error {
+	message.Debugf("helm.rollbackChart(%s, %d)", name, revision)
 	client := action.NewRollback(h.actionConfig)
+	client.Version = revision
 	client.CleanupOnFail = true
 	client.Force = true
 	client.Wait = true
@@ -296,6 +316,55 @@ func (h *Helm) rollbackChart(name string) error {
 	return client.Run(name)
 }
 
+// RollbackChart rolls a chart in the cluster back to the given revision and returns the new revision.
+func (h *Helm) RollbackChart(namespace string, name string, revision int, spinner *message.Spinner) (int, error) {
+	// Establish a new actionConfig for the namespace
+	if err := h.createActionConfig(namespace, spinner); err != nil {
+		return 0, fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	if err := h.rollbackChart(name, revision); err != nil {
+		return 0, err
+	}
+
+	// Rollbacks create a new revision, so look up the latest release
+	current, err := action.NewGet(h.actionConfig).Run(name)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the release %s: %w", name, err)
+	}
+
+	return current.Version, nil
+}
+
+// UpdateReleaseValues upgrades an installed release with its current chart and values merged with the given values
+// (like helm upgrade --reuse-values), waiting for the updated resources to be ready.
+func (h *Helm) UpdateReleaseValues(namespace string, name string, values map[string]any, spinner *message.Spinner) error {
+	message.Debugf("helm.UpdateReleaseValues(%s, %s)", namespace, name)
+
+	// Establish a new actionConfig for the namespace
+	if err := h.createActionConfig(namespace, spinner); err != nil {
+		return fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	current, err := action.NewGet(h.actionConfig).Run(name)
+	if err != nil {
+		return fmt.Errorf("unable to get the release %s: %w", name, err)
+	}
+
+	client := action.NewUpgrade(h.actionConfig)
+	client.Namespace = namespace
+	client.ReuseValues = true
+	client.SkipCRDs = true
+	client.Wait = true
+	client.Timeout = defaultClientTimeout
+
+	if _, err := client.Run(name, current.Chart, values); err != nil {
+		return fmt.Errorf("unable to update the values of the release %s: %w", name, err)
+	}
+
+	return nil
+}
+
 func (h *Helm) uninstallChart(name string) (*release.UninstallReleaseResponse, error) {
 	message.Debugf("helm.uninstallChart(%s)", name)
 	client := action.NewUninstall(h.actionConfig)
@@ -324,7 +393,7 @@ func (h *Helm) loadChartData() (*chart.Chart, map[string]any, error) {
 		if err != nil {
 			return loadedChart, nil, fmt.Errorf("unable to parse chart values: %w", err)
 		}
-		message.Debug(chartValues)
+		message.Debug(h.maskSensitiveValues(fmt.Sprint(chartValues)))
 	} else {
 		// Otherwise, use the overrides instead
 		loadedChart = h.ChartOverride
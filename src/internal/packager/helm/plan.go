@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package helm contains operations for working with helm charts.
+package helm
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+// Helm release plan actions.
+const (
+	PlanActionInstall = "install"
+	PlanActionUpgrade = "upgrade"
+)
+
+// PlanChart renders the chart against the cluster without changing anything and diffs the result
+// against the live helm release. It also returns the namespaces that would be created.
+func (h *Helm) PlanChart() (types.ReleasePlan, []string, error) {
+	spinner := message.NewProgressSpinner("Planning helm chart %s:%s", h.Chart.Name, h.Chart.Version)
+	defer spinner.Stop()
+
+	h.ReleaseName = h.Chart.ReleaseName
+
+	// If no release name is specified, use the chart name
+	if h.ReleaseName == "" {
+		h.ReleaseName = h.Chart.Name
+	}
+
+	plan := types.ReleasePlan{
+		Name:      h.ReleaseName,
+		Namespace: h.Chart.Namespace,
+		Chart:     fmt.Sprintf("%s:%s", h.Chart.Name, h.Chart.Version),
+	}
+
+	// Setup K8s connection
+	if err := h.createActionConfig(h.Chart.Namespace, spinner); err != nil {
+		return plan, nil, fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	postRender, err := h.newRenderer()
+	if err != nil {
+		return plan, nil, fmt.Errorf("unable to create helm renderer: %w", err)
+	}
+	postRender.dryRun = true
+
+	loadedChart, chartValues, err := h.loadChartData()
+	if err != nil {
+		return plan, nil, fmt.Errorf("unable to load chart data: %w", err)
+	}
+
+	histClient := action.NewHistory(h.actionConfig)
+	histClient.Max = 1
+
+	var current string
+	var live, planned *release.Release
+
+	_, histErr := histClient.Run(h.ReleaseName)
+
+	switch histErr {
+	case driver.ErrReleaseNotFound:
+		plan.Action = PlanActionInstall
+
+		client := action.NewInstall(h.actionConfig)
+		client.DryRun = true
+		client.ReleaseName = h.ReleaseName
+		client.Namespace = h.Chart.Namespace
+		client.PostRenderer = postRender
+
+		planned, err = client.Run(loadedChart, chartValues)
+
+	case nil:
+		plan.Action = PlanActionUpgrade
+
+		live, err = action.NewGet(h.actionConfig).Run(h.ReleaseName)
+		if err != nil {
+			return plan, nil, fmt.Errorf("unable to get the live release %s: %w", h.ReleaseName, err)
+		}
+		current = live.Manifest
+
+		client := action.NewUpgrade(h.actionConfig)
+		client.DryRun = true
+		client.Namespace = h.Chart.Namespace
+		client.PostRenderer = postRender
+
+		planned, err = client.Run(h.ReleaseName, loadedChart, chartValues)
+
+	default:
+		return plan, nil, fmt.Errorf("unable to verify the chart installation status: %w", histErr)
+	}
+
+	if err != nil {
+		return plan, nil, fmt.Errorf("unable to render the chart %s: %w", h.Chart.Name, err)
+	}
+	if planned == nil {
+		return plan, nil, fmt.Errorf("unable to render the chart %s: helm returned no release", h.Chart.Name)
+	}
+
+	plan.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(current),
+		B:        difflib.SplitLines(planned.Manifest),
+		FromFile: "live",
+		ToFile:   "planned",
+		Context:  3,
+	})
+	if err != nil {
+		return plan, nil, fmt.Errorf("unable to diff the release manifests: %w", err)
+	}
+
+	spinner.Success()
+
+	return plan, postRender.plannedNamespaces, nil
+}
+
+// PlanManifests generates a helm chart for the given Zarf manifest and plans it like PlanChart.
+func (h *Helm) PlanManifests(manifest types.ZarfManifest) (types.ReleasePlan, []string, error) {
+	if err := h.generateChart(manifest); err != nil {
+		return types.ReleasePlan{}, nil, err
+	}
+
+	return h.PlanChart()
+}
@@ -31,6 +31,10 @@ type renderer struct {
 	connectStrings types.ConnectStrings
 	namespaces     map[string]*corev1.Namespace
 	values         template.Values
+
+	// When dryRun is set, namespaces and secrets are only recorded in plannedNamespaces instead of created
+	dryRun            bool
+	plannedNamespaces []string
 }
 
 func (h *Helm) newRenderer() (*renderer, error) {
@@ -165,6 +169,13 @@ func (r *renderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
 			}
 		}
 
+		if r.dryRun {
+			if !existingNamespace {
+				r.plannedNamespaces = append(r.plannedNamespaces, name)
+			}
+			continue
+		}
+
 		if !existingNamespace {
 			// This is a new namespace, add it
 			if _, err := c.Kube.CreateNamespace(name, namespace); err != nil {
@@ -192,11 +203,7 @@ func (r *renderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
 			}
 
 			// Generate the git server secret
-			gitServerSecret := c.Kube.GenerateSecret(name, config.ZarfGitServerSecretName, corev1.SecretTypeOpaque)
-			gitServerSecret.StringData = map[string]string{
-				"username": r.options.Cfg.State.GitServer.PullUsername,
-				"password": r.options.Cfg.State.GitServer.PullPassword,
-			}
+			gitServerSecret := c.GenerateGitServerPullCreds(name, config.ZarfGitServerSecretName, r.options.Cfg.State.GitServer)
 
 			// Create or update the git server secret
 			if err := c.Kube.CreateOrUpdateSecret(gitServerSecret); err != nil {
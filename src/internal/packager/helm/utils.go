@@ -9,7 +9,9 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
+	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
@@ -20,6 +22,9 @@ import (
 	"helm.sh/helm/v3/pkg/chart/loader"
 )
 
+// helmValueEscaper escapes the characters that would otherwise split a --set style value.
+var helmValueEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`)
+
 // loadChartFromTarball returns a helm chart from a tarball.
 func (h *Helm) loadChartFromTarball() (*chart.Chart, error) {
 	// Get the path the temporary helm chart tarball
@@ -54,6 +59,32 @@ func (h *Helm) parseChartValues() (map[string]any, error) {
 		valueOpts.ValueFiles = append(valueOpts.ValueFiles, path)
 	}
 
+	if h.Cfg != nil {
+		// Values files given at deploy time are merged on top of the packaged values
+		for key, path := range h.Cfg.DeployOpts.ValuesOverrides {
+			if strings.EqualFold(key, h.Component.Name+"/"+h.Chart.Name) {
+				valueOpts.ValueFiles = append(valueOpts.ValueFiles, path)
+			}
+		}
+
+		// Variables with a path for this chart (or for every chart) set that chart value directly (these take precedence over values files like --set)
+		for _, variable := range h.Cfg.Pkg.Variables {
+			value, ok := h.Cfg.SetVariableMap[variable.Name]
+			if !ok || variable.Path == "" {
+				continue
+			}
+
+			target, valuePath, found := strings.Cut(variable.Path, ":")
+			if !found {
+				valuePath = variable.Path
+			} else if !strings.EqualFold(target, h.Component.Name+"/"+h.Chart.Name) {
+				continue
+			}
+
+			valueOpts.Values = append(valueOpts.Values, valuePath+"="+helmValueEscaper.Replace(value))
+		}
+	}
+
 	httpProvider := getter.Provider{
 		Schemes: []string{"http", "https"},
 		New:     getter.NewHTTPGetter,
@@ -63,6 +94,21 @@ func (h *Helm) parseChartValues() (map[string]any, error) {
 	return valueOpts.MergeValues(providers)
 }
 
+// maskSensitiveValues replaces the values of sensitive package variables in debug output.
+func (h *Helm) maskSensitiveValues(output string) string {
+	if h.Cfg == nil {
+		return output
+	}
+
+	for _, variable := range h.Cfg.Pkg.Variables {
+		if value := h.Cfg.SetVariableMap[variable.Name]; variable.Sensitive && value != "" {
+			output = strings.ReplaceAll(output, value, config.ZarfMaskedValue)
+		}
+	}
+
+	return output
+}
+
 func (h *Helm) createActionConfig(namespace string, spinner *message.Spinner) error {
 	// OMG THIS IS SOOOO GROSS PPL... https://github.com/helm/helm/issues/8780
 	_ = os.Setenv("HELM_NAMESPACE", namespace)
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package helm contains operations for working with helm charts.
+package helm
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// UpdateZarfRegistryValues updates the Zarf registry deployment with the registry credentials in the given state.
+func (h *Helm) UpdateZarfRegistryValues(state types.ZarfState) error {
+	spinner := message.NewProgressSpinner("Updating the Zarf registry credentials")
+	defer spinner.Stop()
+
+	regInfo := state.RegistryInfo
+	pushUser, err := utils.GetHtpasswdString(regInfo.PushUsername, regInfo.PushPassword)
+	if err != nil {
+		return fmt.Errorf("error generating htpasswd string: %w", err)
+	}
+	pullUser, err := utils.GetHtpasswdString(regInfo.PullUsername, regInfo.PullPassword)
+	if err != nil {
+		return fmt.Errorf("error generating htpasswd string: %w", err)
+	}
+
+	// The registry deployment is annotated with a checksum of this secret, so changing it restarts the registry
+	values := map[string]any{
+		"secrets": map[string]any{
+			"htpasswd": fmt.Sprintf("%s\n%s", pushUser, pullUser),
+		},
+	}
+	if err := h.UpdateReleaseValues(cluster.ZarfNamespace, cluster.ZarfRegistryReleaseName, values, spinner); err != nil {
+		return err
+	}
+
+	spinner.Success()
+	return nil
+}
+
+// UpdateZarfGiteaValues updates the Zarf Gitea deployment with the admin credentials in the given state.
+func (h *Helm) UpdateZarfGiteaValues(state types.ZarfState) error {
+	spinner := message.NewProgressSpinner("Updating the Zarf git server credentials")
+	defer spinner.Stop()
+
+	// The Gitea init script resets the admin password to this value whenever Gitea restarts
+	values := map[string]any{
+		"gitea": map[string]any{
+			"admin": map[string]any{
+				"username": state.GitServer.PushUsername,
+				"password": state.GitServer.PushPassword,
+			},
+		},
+	}
+	if err := h.UpdateReleaseValues(cluster.ZarfNamespace, cluster.ZarfGitServerReleaseName, values, spinner); err != nil {
+		return err
+	}
+
+	spinner.Success()
+	return nil
+}
@@ -4,14 +4,36 @@
 // Package images provides functions for building and pushing images.
 package images
 
-import "github.com/defenseunicorns/zarf/src/types"
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+)
+
+// ImageRefAnnotation records the reference an image was pulled from on its entry in the image layout index.
+const ImageRefAnnotation = "org.opencontainers.image.ref.name"
 
 // ImgConfig is the main struct for managing container images.
 type ImgConfig struct {
+	// ImagesPath is the OCI image layout the package images are stored in
+	ImagesPath string
+
+	// TarballPath is the docker tarball used for the seed image (and by packages created by older versions of Zarf)
 	TarballPath string
 
 	ImgList []string
 
+	// Pinned are the digests to pull images at instead of their tags (e.g. from the zarf.lock)
+	Pinned map[string]string
+
 	RegInfo types.RegistryInfo
 
 	NoChecksum bool
@@ -19,4 +41,111 @@ type ImgConfig struct {
 	Insecure bool
 
 	NoLocalImages bool
+
+	Concurrency int
+
+	// InUse are the images other deployed components still use, which are kept when deleting images
+	InUse []string
+}
+
+// imageLoader returns a function that loads an image from the OCI image layout, only reading the image manifest until the
+// image is used. If there is no image layout the images are loaded from the docker tarball instead.
+func (i *ImgConfig) imageLoader() (func(src string) (v1.Image, error), error) {
+	if i.ImagesPath == "" || utils.InvalidPath(filepath.Join(i.ImagesPath, "index.json")) {
+		return func(src string) (v1.Image, error) {
+			return crane.LoadTag(i.TarballPath, src, config.GetCraneOptions(i.Insecure)...)
+		}, nil
+	}
+
+	layoutPath := layout.Path(i.ImagesPath)
+	digests, err := layoutDigests(layoutPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(src string) (v1.Image, error) {
+		digest, ok := digests[src]
+		if !ok {
+			return nil, fmt.Errorf("image %s was not found in the package", src)
+		}
+		return layoutPath.Image(digest)
+	}, nil
+}
+
+// ImageDigests returns the digest of every image in the OCI image layout by the reference it was pulled from.
+func ImageDigests(imagesPath string) (map[string]string, error) {
+	digests, err := layoutDigests(layout.Path(imagesPath))
+	if err != nil {
+		return nil, err
+	}
+
+	imageDigests := make(map[string]string)
+	for src, digest := range digests {
+		imageDigests[src] = digest.String()
+	}
+
+	return imageDigests, nil
+}
+
+func layoutDigests(layoutPath layout.Path) (map[string]v1.Hash, error) {
+	index, err := layoutPath.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the image layout index: %w", err)
+	}
+
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the image layout index: %w", err)
+	}
+
+	digests := make(map[string]v1.Hash)
+	for _, desc := range indexManifest.Manifests {
+		if src, ok := desc.Annotations[ImageRefAnnotation]; ok {
+			digests[src] = desc.Digest
+		}
+	}
+
+	return digests, nil
+}
+
+// forEachImage runs fn for every image in the list using a bounded pool of workers.
+// A failed image does not stop the others, every failure is returned together once all images have been attempted.
+func (i *ImgConfig) forEachImage(fn func(src string) error) error {
+	concurrency := i.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		waitGroup sync.WaitGroup
+		mutex     sync.Mutex
+		failures  []string
+		queue     = make(chan string)
+	)
+
+	for w := 0; w < concurrency; w++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for src := range queue {
+				if err := fn(src); err != nil {
+					mutex.Lock()
+					failures = append(failures, err.Error())
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, src := range i.ImgList {
+		queue <- src
+	}
+	close(queue)
+	waitGroup.Wait()
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d images failed: %s", len(failures), len(i.ImgList), strings.Join(failures, "; "))
+	}
+
+	return nil
 }
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package images provides functions for building and pushing images.
+package images
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// DeleteFromZarfRegistry removes the manifests of the provided images (both the checksum and non-checksum names) from the Zarf registry.
+// Note: the registry must have deletes enabled and the blobs are only reclaimed after the registry garbage collection runs.
+func (i *ImgConfig) DeleteFromZarfRegistry() error {
+	message.Debugf("images.DeleteFromZarfRegistry(%#v)", i)
+
+	tunnel, registryURL, err := i.connectToZarfRegistry()
+	if err != nil {
+		return err
+	}
+	if tunnel != nil {
+		defer tunnel.Close()
+	}
+
+	spinner := message.NewProgressSpinner("Removing images from the zarf registry")
+	defer spinner.Stop()
+
+	options := []crane.Option{config.GetCraneAuthOption(i.RegInfo.PushUsername, i.RegInfo.PushPassword)}
+	options = append(options, config.GetCraneOptions(i.Insecure)...)
+
+	// Images from different hosts can share a non-checksum name (e.g. ghcr.io/x/y and docker.io/x/y), so keep the
+	// repositories that an image still in use maps to
+	sharedRepos := map[string]bool{}
+	for _, src := range i.InUse {
+		if repo, err := noChecksumRepo(src, registryURL); err == nil {
+			sharedRepos[repo] = true
+		}
+	}
+
+	// Deleting a manifest removes every tag that points at it, so keep the manifests of the images still in use
+	// in the repositories images are deleted from
+	targetRepos := map[string]bool{}
+	for _, src := range i.ImgList {
+		for _, image := range offlineNames(src, registryURL) {
+			if ref, err := name.ParseReference(image); err == nil {
+				targetRepos[ref.Context().Name()] = true
+			}
+		}
+	}
+	inUseManifests, err := manifestsInUse(i.InUse, targetRepos, registryURL, options)
+	if err != nil {
+		return err
+	}
+
+	for _, src := range i.ImgList {
+		spinner.Updatef("Removing image %s", src)
+
+		offlineNameCRC, err := utils.SwapHost(src, registryURL)
+		if err != nil {
+			return err
+		}
+
+		offlineName, err := utils.SwapHostWithoutChecksum(src, registryURL)
+		if err != nil {
+			return err
+		}
+
+		repo, err := noChecksumRepo(src, registryURL)
+		if err != nil {
+			return err
+		}
+
+		targets := []string{offlineNameCRC}
+		if sharedRepos[repo] {
+			message.Debugf("Keeping %s, another deployed image uses the same name", offlineName)
+		} else {
+			targets = append(targets, offlineName)
+		}
+
+		for _, image := range targets {
+			if err := deleteImage(image, inUseManifests, options); err != nil {
+				return fmt.Errorf("unable to remove the image %s: %w", image, err)
+			}
+		}
+	}
+
+	spinner.Success()
+	return nil
+}
+
+// deleteImage resolves the image to its digest and deletes that manifest, ignoring images that no longer exist and
+// skipping manifests that are in use (as repo@digest) by another tag.
+func deleteImage(image string, inUseManifests map[string]bool, options []crane.Option) error {
+	digest, err := crane.Digest(image, options...)
+	if isNotFound(err) {
+		message.Debugf("Image %s was not found in the registry, skipping", image)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return err
+	}
+
+	manifest := ref.Context().Digest(digest).String()
+	if inUseManifests[manifest] {
+		message.Debugf("Keeping %s, a deployed image uses the same manifest", image)
+		return nil
+	}
+
+	message.Debugf("crane.Delete() %s", manifest)
+	if err := crane.Delete(manifest, options...); err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// manifestsInUse returns the manifests (as repo@digest) of the checksum and non-checksum names of the given images
+// that are in one of the given repositories.
+func manifestsInUse(inUse []string, repos map[string]bool, registryURL string, options []crane.Option) (map[string]bool, error) {
+	manifests := map[string]bool{}
+
+	for _, src := range inUse {
+		for _, image := range offlineNames(src, registryURL) {
+			ref, err := name.ParseReference(image)
+			if err != nil || !repos[ref.Context().Name()] {
+				continue
+			}
+
+			digest, err := crane.Digest(image, options...)
+			if isNotFound(err) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("unable to get the digest of the image %s that is still in use: %w", image, err)
+			}
+
+			manifests[ref.Context().Digest(digest).String()] = true
+		}
+	}
+
+	return manifests, nil
+}
+
+// offlineNames returns the checksum and non-checksum names of an image in the Zarf registry.
+func offlineNames(src string, registryURL string) []string {
+	var names []string
+	if offlineNameCRC, err := utils.SwapHost(src, registryURL); err == nil {
+		names = append(names, offlineNameCRC)
+	}
+	if offlineName, err := utils.SwapHostWithoutChecksum(src, registryURL); err == nil {
+		names = append(names, offlineName)
+	}
+	return names
+}
+
+// noChecksumRepo returns the repository the image is pushed to under its non-checksum name.
+func noChecksumRepo(src string, registryURL string) (string, error) {
+	offlineName, err := utils.SwapHostWithoutChecksum(src, registryURL)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := name.ParseReference(offlineName)
+	if err != nil {
+		return "", err
+	}
+
+	return ref.Context().Name(), nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package images provides functions for building and pushing images.
+package images
+
+import (
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteImageKeepsManifestsInUse(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	registryURL := strings.TrimPrefix(server.URL, "http://")
+	options := []crane.Option{crane.Insecure}
+
+	shared, err := random.Image(64, 1)
+	require.NoError(t, err)
+	other, err := random.Image(64, 1)
+	require.NoError(t, err)
+
+	// Push two tags of the same content and a tag of other content under their checksum names
+	offline := map[string]string{}
+	for src, img := range map[string]v1.Image{"nginx:1.23": shared, "nginx:1.23.1": shared, "nginx:1.24": other} {
+		offlineName, err := utils.SwapHost(src, registryURL)
+		require.NoError(t, err)
+		require.NoError(t, crane.Push(img, offlineName, options...))
+		offline[src] = offlineName
+	}
+
+	ref, err := name.ParseReference(offline["nginx:1.23"])
+	require.NoError(t, err)
+	repos := map[string]bool{ref.Context().Name(): true}
+
+	inUse, err := manifestsInUse([]string{"nginx:1.23.1", "alpine:3.15"}, repos, registryURL, options)
+	require.NoError(t, err)
+	require.Len(t, inUse, 1)
+
+	// manifest returns the repo@digest reference of the manifest the image points at
+	manifest := func(img v1.Image) string {
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		return ref.Context().Digest(digest.String()).String()
+	}
+
+	// The tag in use points at the same manifest, so it is kept
+	require.NoError(t, deleteImage(offline["nginx:1.23"], inUse, options))
+	_, err = crane.Digest(manifest(shared), options...)
+	require.NoError(t, err)
+
+	// Content that is not in use is deleted
+	require.NoError(t, deleteImage(offline["nginx:1.24"], inUse, options))
+	_, err = crane.Digest(manifest(other), options...)
+	require.True(t, isNotFound(err), err)
+
+	// Images that no longer exist are skipped
+	require.NoError(t, deleteImage(manifest(other), inUse, options))
+}
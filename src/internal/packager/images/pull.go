@@ -14,7 +14,10 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
@@ -25,6 +28,8 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/cache"
 	"github.com/google/go-containerregistry/pkg/v1/daemon"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
 )
 
@@ -42,7 +47,7 @@ func (i *ImgConfig) PullAll() (map[name.Tag]v1.Image, error) {
 		longer = "This step may take several seconds to complete."
 	}
 
-	spinner := message.NewProgressSpinner("Loading metadata for %d images. %s", imgCount, longer)
+	spinner := message.NewProgressSpinner("Fetching %d images. %s", imgCount, longer)
 	defer spinner.Stop()
 
 	imageMap := map[string]v1.Image{}
@@ -52,17 +57,43 @@ func (i *ImgConfig) PullAll() (map[name.Tag]v1.Image, error) {
 		logs.Progress.SetOutput(spinner)
 	}
 
-	for idx, src := range i.ImgList {
-		spinner.Updatef("Fetching image metadata (%d of %d): %s", idx+1, imgCount, src)
+	var (
+		mutex    sync.Mutex
+		fetched  int
+		inflight sync.Map
+	)
 
-		img, err := i.pullImage(src)
-		if err != nil {
-			return nil, fmt.Errorf("failed to pull image %s: %w", src, err)
-		}
-		imageMap[src] = img
+	// Fetch the images in parallel, retrying each image on its own so one flaky image does not restart the rest
+	err := i.forEachImage(func(src string) error {
+		return utils.RetryWithBackoff(func() error {
+			img, err := i.pullImage(src, &inflight)
+			if err != nil {
+				message.Debugf("unable to fetch image %s: %s", src, err.Error())
+				return fmt.Errorf("failed to pull image %s: %w", src, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			imageMap[src] = img
+			fetched++
+			spinner.Updatef("Fetched image (%d of %d): %s", fetched, imgCount, src)
+
+			return nil
+		}, 3, 5*time.Second)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	spinner.Updatef("Creating image tarball (this will take a while)")
+	// Write the images into the OCI image layout, the seed image still uses a docker tarball
+	if i.ImagesPath != "" {
+		spinner.Success()
+		if imageMap, err = i.writeLayout(imageMap); err != nil {
+			return nil, err
+		}
+	} else {
+		spinner.Updatef("Creating image tarball (this will take a while)")
+	}
 
 	tagToImage := map[name.Tag]v1.Image{}
 
@@ -82,6 +113,10 @@ func (i *ImgConfig) PullAll() (map[name.Tag]v1.Image, error) {
 		}
 		tagToImage[tag] = img
 	}
+
+	if i.ImagesPath != "" {
+		return tagToImage, nil
+	}
 	spinner.Success()
 
 	progress := make(chan v1.Update, 200)
@@ -119,14 +154,68 @@ func (i *ImgConfig) PullAll() (map[name.Tag]v1.Image, error) {
 	return tagToImage, nil
 }
 
+// writeLayout adds the images to the OCI image layout at ImagesPath, storing blobs shared between images only once.
+// The returned images are read back from the layout.
+func (i *ImgConfig) writeLayout(imageMap map[string]v1.Image) (map[string]v1.Image, error) {
+	// Start from an empty index, blobs left behind by an earlier attempt are reused
+	layoutPath, err := layout.Write(i.ImagesPath, empty.Index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the image layout: %w", err)
+	}
+
+	// Add the images in a stable order so the index is reproducible
+	var sources []string
+	for src := range imageMap {
+		sources = append(sources, src)
+	}
+	sort.Strings(sources)
+
+	progressBar := message.NewProgressBar(int64(len(sources)), "Writing %d images", len(sources))
+	defer progressBar.Stop()
+
+	layoutImages := map[string]v1.Image{}
+	for idx, src := range sources {
+		progressBar.Update(int64(idx), fmt.Sprintf("Writing image (%d of %d): %s", idx+1, len(sources), src))
+
+		img := imageMap[src]
+		if err := layoutPath.AppendImage(img, layout.WithAnnotations(map[string]string{ImageRefAnnotation: src})); err != nil {
+			return nil, fmt.Errorf("failed to write image %s: %w", src, err)
+		}
+
+		digest, err := img.Digest()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the digest of image %s: %w", src, err)
+		}
+
+		if layoutImages[src], err = layoutPath.Image(digest); err != nil {
+			return nil, fmt.Errorf("failed to read image %s from the image layout: %w", src, err)
+		}
+	}
+
+	size, _ := utils.GetDirSize(i.ImagesPath)
+	progressBar.Success("Pulling %d images (%s)", len(sources), utils.ByteFormat(float64(size), 2))
+
+	return layoutImages, nil
+}
+
 // pullImage returns a v1.Image either by loading a local tarball, the pulling from the local daemon, or the wider internet
-func (i *ImgConfig) pullImage(src string) (v1.Image, error) {
+// Layers pulled from the internet are downloaded into the cache, skipping any layer another image is already downloading.
+func (i *ImgConfig) pullImage(src string, inflight *sync.Map) (v1.Image, error) {
 	// Load image tarballs from the local filesystem
 	if strings.HasSuffix(src, ".tar") || strings.HasSuffix(src, ".tar.gz") || strings.HasSuffix(src, ".tgz") {
 		message.Debugf("loading image tarball: %s", src)
 		return crane.Load(src, config.GetCraneOptions(true)...)
 	}
 
+	// Pull pinned images by their digest, falling back to the tag if the digest can't be pulled (e.g. a local image)
+	if digest, ok := i.Pinned[src]; ok {
+		img, err := i.pullPinnedImage(src, digest, inflight)
+		if err == nil {
+			return img, nil
+		}
+		message.Debugf("unable to pull image %s at the pinned digest %s, pulling the tag instead: %s", src, digest, err.Error())
+	}
+
 	// Unless disabled, attempt to pull the image from the local daemon
 	if !i.NoLocalImages {
 		reference, err := name.ParseReference(src)
@@ -143,6 +232,21 @@ func (i *ImgConfig) pullImage(src string) (v1.Image, error) {
 	}
 
 	// We were unable to pull from the local daemon, so attempt to pull from the wider internet
+	return i.pullRemoteImage(src, inflight)
+}
+
+// pullPinnedImage pulls an image from its registry at the given digest.
+func (i *ImgConfig) pullPinnedImage(src, digest string, inflight *sync.Map) (v1.Image, error) {
+	ref, err := name.ParseReference(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse image reference %s: %w", src, err)
+	}
+
+	return i.pullRemoteImage(ref.Context().Digest(digest).String(), inflight)
+}
+
+// pullRemoteImage pulls an image from its registry, downloading its layers into the cache.
+func (i *ImgConfig) pullRemoteImage(src string, inflight *sync.Map) (v1.Image, error) {
 	img, err := crane.Pull(src, config.GetCraneOptions(i.Insecure)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull image %s: %w", src, err)
@@ -150,11 +254,62 @@ func (i *ImgConfig) pullImage(src string) (v1.Image, error) {
 
 	message.Debugf("loading image with cache: %s", src)
 	imageCachePath := filepath.Join(config.GetAbsCachePath(), config.ZarfImageCacheDir)
-	img = cache.Image(img, cache.NewFilesystemCache(imageCachePath))
+	layerCache := cache.NewFilesystemCache(imageCachePath)
+	img = cache.Image(img, layerCache)
+
+	if err := cacheLayers(img, layerCache, inflight); err != nil {
+		return nil, fmt.Errorf("failed to download the layers of image %s: %w", src, err)
+	}
 
 	return img, nil
 }
 
+// cacheLayers reads every layer of a cached image so the layers download now (in parallel with other images)
+// instead of one at a time while the image tarball is written.
+func cacheLayers(img v1.Image, layerCache cache.Cache, inflight *sync.Map) error {
+	layers, err := img.Layers()
+	if err != nil {
+		return err
+	}
+
+	for _, layer := range layers {
+		digest, err := layer.Digest()
+		if err != nil {
+			return err
+		}
+
+		// Skip layers already in the cache
+		if _, err := layerCache.Get(digest); err == nil {
+			continue
+		}
+
+		// Two writers to the same cache file would corrupt it, so only one image downloads a shared layer
+		if _, loaded := inflight.LoadOrStore(digest.String(), true); loaded {
+			continue
+		}
+
+		if err := readLayer(layer); err != nil {
+			// Drop the partially written layer from the cache and allow a retry to download it again
+			_ = layerCache.Delete(digest)
+			inflight.Delete(digest.String())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readLayer(layer v1.Layer) error {
+	reader, err := layer.Compressed()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = io.Copy(io.Discard, reader)
+	return err
+}
+
 // FormatCraneOCILayout ensures that all images are in the OCI format.
 func FormatCraneOCILayout(ociPath string) error {
 	type IndexJSON struct {
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package images provides functions for building and pushing images.
+package images
+
+import (
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullImagePinned(t *testing.T) {
+	config.CommonOptions.CachePath = t.TempDir()
+
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	src := strings.TrimPrefix(server.URL, "http://") + "/library/nginx:1.23"
+
+	// Push an image and then move the tag to different content
+	locked, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(locked, src, crane.Insecure))
+	lockedDigest, err := locked.Digest()
+	require.NoError(t, err)
+
+	moved, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(moved, src, crane.Insecure))
+	movedDigest, err := moved.Digest()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		pinned   map[string]string
+		expected string
+	}{
+		{
+			name:     "not pinned",
+			expected: movedDigest.String(),
+		},
+		{
+			name:     "pinned",
+			pinned:   map[string]string{src: lockedDigest.String()},
+			expected: lockedDigest.String(),
+		},
+		{
+			name:     "pinned digest is missing",
+			pinned:   map[string]string{src: "sha256:" + strings.Repeat("0", 64)},
+			expected: movedDigest.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imgConfig := ImgConfig{Pinned: tt.pinned, Insecure: true, NoLocalImages: true}
+
+			img, err := imgConfig.pullImage(src, &sync.Map{})
+			require.NoError(t, err)
+
+			digest, err := img.Digest()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, digest.String())
+		})
+	}
+}
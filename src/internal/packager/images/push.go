@@ -5,11 +5,16 @@
 package images
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/internal/cluster"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 )
 
 // PushToZarfRegistry pushes a provided image into the configured Zarf registry
@@ -17,30 +22,12 @@ import (
 func (i *ImgConfig) PushToZarfRegistry() error {
 	message.Debugf("images.PushToZarfRegistry(%#v)", i)
 
-	var (
-		err         error
-		tunnel      *cluster.Tunnel
-		registryURL string
-		target      string
-	)
-
-	if i.RegInfo.InternalRegistry {
-		// Establish a registry tunnel to send the images to the zarf registry
-		if tunnel, err = cluster.NewZarfTunnel(); err != nil {
-			return err
-		}
-		target = cluster.ZarfRegistry
-	} else if cluster.IsServiceURL(i.RegInfo.Address) {
-		// If this is a serviceURL, create a port-forward tunnel to that resource
-		if tunnel, err = cluster.NewTunnelFromServiceURL(i.RegInfo.Address); err != nil {
-			return err
-		}
+	tunnel, registryURL, err := i.connectToZarfRegistry()
+	if err != nil {
+		return err
 	}
-
 	if tunnel != nil {
-		tunnel.Connect(target, false)
 		defer tunnel.Close()
-		registryURL = tunnel.Endpoint()
 	}
 
 	spinner := message.NewProgressSpinner("Storing images in the zarf registry")
@@ -49,41 +36,105 @@ func (i *ImgConfig) PushToZarfRegistry() error {
 	pushOptions := config.GetCraneAuthOption(i.RegInfo.PushUsername, i.RegInfo.PushPassword)
 	message.Debugf("crane pushOptions = %#v", pushOptions)
 
-	for _, src := range i.ImgList {
-		spinner.Updatef("Updating image %s", src)
-		img, err := crane.LoadTag(i.TarballPath, src, config.GetCraneOptions(i.Insecure)...)
+	loadImage, err := i.imageLoader()
+	if err != nil {
+		return err
+	}
+
+	var (
+		mutex  sync.Mutex
+		pushed int
+	)
+
+	// Push the images in parallel, retrying each push on its own so one flaky image does not restart the rest
+	err = i.forEachImage(func(src string) error {
+		img, err := loadImage(src)
 		if err != nil {
 			return err
 		}
 
+		var targets []string
+
 		// If this is not a no checksum image push it for use with the Zarf agent
 		if !i.NoChecksum {
 			offlineNameCRC, err := utils.SwapHost(src, registryURL)
 			if err != nil {
 				return err
 			}
-
-			message.Debugf("crane.Push() %s:%s -> %s)", i.TarballPath, src, offlineNameCRC)
-
-			if err = crane.Push(img, offlineNameCRC, pushOptions); err != nil {
-				return err
-			}
+			targets = append(targets, offlineNameCRC)
 		}
 
 		// To allow for other non-zarf workloads to easily see the images upload a non-checksum version
-		// (this may result in collisions but this is acceptable for this use case)
+		// (this may result in collisions but this is acceptable for this use case, pods can be pinned to digests by the Zarf Agent)
 		offlineName, err := utils.SwapHostWithoutChecksum(src, registryURL)
 		if err != nil {
 			return err
 		}
+		targets = append(targets, offlineName)
 
-		message.Debugf("crane.Push() %s:%s -> %s)", i.TarballPath, src, offlineName)
+		for _, target := range targets {
+			message.Debugf("crane.Push() %s -> %s)", src, target)
 
-		if err = crane.Push(img, offlineName, pushOptions); err != nil {
-			return err
+			err := utils.RetryWithBackoff(func() error {
+				return pushImage(img, target, pushOptions)
+			}, 3, 5*time.Second)
+			if err != nil {
+				return fmt.Errorf("unable to push image %s: %w", src, err)
+			}
 		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		pushed++
+		spinner.Updatef("Stored image (%d of %d): %s", pushed, len(i.ImgList), src)
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	spinner.Success()
 	return nil
 }
+
+// pushImage pushes an image unless the registry already has a manifest with the same digest.
+// When the image is pushed, only the blobs the registry does not already have are uploaded.
+func pushImage(img v1.Image, target string, pushOptions crane.Option) error {
+	digest, err := img.Digest()
+	if err != nil {
+		return err
+	}
+
+	if existing, err := crane.Digest(target, pushOptions); err == nil && existing == digest.String() {
+		message.Debugf("Skipping %s, the registry already has %s", target, existing)
+		return nil
+	}
+
+	return crane.Push(img, target, pushOptions)
+}
+
+// connectToZarfRegistry opens a tunnel to the registry if needed and returns the address to use for it.
+func (i *ImgConfig) connectToZarfRegistry() (tunnel *cluster.Tunnel, registryURL string, err error) {
+	var target string
+
+	if i.RegInfo.InternalRegistry {
+		// Establish a registry tunnel to send the images to the zarf registry
+		if tunnel, err = cluster.NewZarfTunnel(); err != nil {
+			return nil, "", err
+		}
+		target = cluster.ZarfRegistry
+	} else if cluster.IsServiceURL(i.RegInfo.Address) {
+		// If this is a serviceURL, create a port-forward tunnel to that resource
+		if tunnel, err = cluster.NewTunnelFromServiceURL(i.RegInfo.Address); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if tunnel != nil {
+		tunnel.Connect(target, false)
+		registryURL = tunnel.Endpoint()
+	}
+
+	return tunnel, registryURL, nil
+}
@@ -25,16 +25,14 @@ import (
 	"github.com/defenseunicorns/zarf/src/types"
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
-	"github.com/google/go-containerregistry/pkg/v1/tarball"
 )
 
 // Builder is the main struct used to build SBOM artifacts.
 type Builder struct {
-	spinner    *message.Spinner
-	cachePath  string
-	imagesPath string
-	sbomPath   string
-	jsonList   []byte
+	spinner   *message.Spinner
+	cachePath string
+	sbomPath  string
+	jsonList  []byte
 }
 
 //go:embed viewer/*
@@ -44,14 +42,13 @@ var transformRegex = regexp.MustCompile(`(?m)[^a-zA-Z0-9\.\-]`)
 var componentPrefix = "zarf-component-"
 
 // Catalog catalogs the given components and images to create an SBOM.
-func Catalog(componentSBOMs map[string]*types.ComponentSBOM, tagToImage map[name.Tag]v1.Image, imagesPath, sbomPath string) {
+func Catalog(componentSBOMs map[string]*types.ComponentSBOM, tagToImage map[name.Tag]v1.Image, sbomPath string) {
 	imageCount := len(tagToImage)
 	componentCount := len(componentSBOMs)
 	builder := Builder{
-		spinner:    message.NewProgressSpinner("Creating SBOMs for %d images and %d components with files.", imageCount, componentCount),
-		cachePath:  config.GetAbsCachePath(),
-		imagesPath: imagesPath,
-		sbomPath:   sbomPath,
+		spinner:   message.NewProgressSpinner("Creating SBOMs for %d images and %d components with files.", imageCount, componentCount),
+		cachePath: config.GetAbsCachePath(),
+		sbomPath:  sbomPath,
 	}
 	defer builder.spinner.Stop()
 
@@ -71,7 +68,7 @@ func Catalog(componentSBOMs map[string]*types.ComponentSBOM, tagToImage map[name
 	for tag := range tagToImage {
 		builder.spinner.Updatef("Creating image SBOMs (%d of %d): %s", currImage, imageCount, tag)
 
-		jsonData, err := builder.createImageSBOM(tag)
+		jsonData, err := builder.createImageSBOM(tag, tagToImage[tag])
 		if err != nil {
 			builder.spinner.Fatalf(err, "Unable to create SBOM for image %s", tag)
 		}
@@ -117,16 +114,10 @@ func Catalog(componentSBOMs map[string]*types.ComponentSBOM, tagToImage map[name
 
 // createImageSBOM uses syft to generate SBOM for an image,
 // some code/structure migrated from https://github.com/testifysec/go-witness/blob/v0.1.12/attestation/syft/syft.go.
-func (b *Builder) createImageSBOM(tag name.Tag) ([]byte, error) {
-	// Get the image
-	tarballImg, err := tarball.ImageFromPath(b.imagesPath, &tag)
-	if err != nil {
-		return nil, err
-	}
-
+func (b *Builder) createImageSBOM(tag name.Tag, img v1.Image) ([]byte, error) {
 	// Create the sbom
 	imageCachePath := filepath.Join(b.cachePath, config.ZarfImageCacheDir)
-	syftImage := image.NewImage(tarballImg, imageCachePath, image.WithTags(tag.String()))
+	syftImage := image.NewImage(img, imageCachePath, image.WithTags(tag.String()))
 	if err := syftImage.Read(); err != nil {
 		return nil, err
 	}
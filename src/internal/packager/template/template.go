@@ -16,6 +16,12 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 )
 
+// sensitiveBuiltins are the builtin template keys whose values are masked in debug output.
+var sensitiveBuiltins = []string{
+	"REGISTRY_AUTH_PUSH", "REGISTRY_AUTH_PULL", "GIT_AUTH_PUSH", "GIT_AUTH_PULL",
+	"AGENT_KEY", "HTPASSWD", "REGISTRY_SECRET", "LOGGING_AUTH",
+}
+
 // Values contains the values to be used in the template.
 type Values struct {
 	config   *types.PackagerConfig
@@ -137,8 +143,34 @@ func (values Values) Apply(component types.ZarfComponent, path string, ignoreRea
 		templateMap[strings.ToUpper(fmt.Sprintf("###ZARF_CONST_%s###", constant.Name))] = constant.Value
 	}
 
-	message.Debugf("templateMap = %#v", templateMap)
+	message.Debugf("templateMap = %#v", values.debugTemplateMap(templateMap))
 	utils.ReplaceTextTemplate(path, templateMap, deprecations)
 
 	return nil
 }
+
+// debugTemplateMap returns a copy of the template map with the builtin secrets and sensitive variables masked.
+func (values Values) debugTemplateMap(templateMap map[string]string) map[string]string {
+	debugMap := make(map[string]string, len(templateMap))
+	for key, value := range templateMap {
+		debugMap[key] = value
+	}
+
+	var sensitiveKeys []string
+	for _, key := range sensitiveBuiltins {
+		sensitiveKeys = append(sensitiveKeys, fmt.Sprintf("###ZARF_%s###", key))
+	}
+	for _, variable := range values.config.Pkg.Variables {
+		if variable.Sensitive {
+			sensitiveKeys = append(sensitiveKeys, fmt.Sprintf("###ZARF_VAR_%s###", variable.Name))
+		}
+	}
+
+	for _, key := range sensitiveKeys {
+		if _, ok := debugMap[key]; ok {
+			debugMap[key] = config.ZarfMaskedValue
+		}
+	}
+
+	return debugMap
+}
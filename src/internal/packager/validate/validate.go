@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
@@ -31,6 +32,9 @@ func Run(pkg types.ZarfPackage) error {
 		if err := validatePackageVariable(variable); err != nil {
 			return fmt.Errorf(lang.PkgValidateErrVariable, err)
 		}
+		if err := validateVariablePath(variable, pkg.Components); err != nil {
+			return fmt.Errorf(lang.PkgValidateErrVariable, err)
+		}
 	}
 
 	for _, constant := range pkg.Constants {
@@ -53,6 +57,10 @@ func Run(pkg types.ZarfPackage) error {
 		}
 	}
 
+	if err := validateDependencies(pkg.Components); err != nil {
+		return fmt.Errorf(lang.PkgValidateErrComponent, err)
+	}
+
 	return nil
 }
 
@@ -113,6 +121,22 @@ func validateComponent(pkg types.ZarfPackage, component types.ZarfComponent) err
 		}
 	}
 
+	for _, data := range component.DataInjections {
+		if err := validateDataInjection(data); err != nil {
+			return fmt.Errorf(lang.PkgValidateErrDataInjection, component.Name, err)
+		}
+	}
+
+	for _, wait := range component.Wait {
+		if err := validateWait(wait); err != nil {
+			return fmt.Errorf(lang.PkgValidateErrWait, component.Name, err)
+		}
+	}
+
+	if err := validateActions(component.Actions); err != nil {
+		return fmt.Errorf(lang.PkgValidateErrAction, component.Name, err)
+	}
+
 	if pkg.Metadata.YOLO {
 		if err := validateYOLO(component); err != nil {
 			return fmt.Errorf(lang.PkgValidateErrComponentYOLO, component.Name, err)
@@ -122,6 +146,68 @@ func validateComponent(pkg types.ZarfPackage, component types.ZarfComponent) err
 	return nil
 }
 
+func validateDependencies(components []types.ZarfComponent) error {
+	dependsOn := make(map[string][]string)
+	for _, component := range components {
+		dependsOn[component.Name] = component.DependsOn
+	}
+
+	// Ensure every dependency exists in the package
+	for _, component := range components {
+		for _, dependency := range component.DependsOn {
+			if dependency == component.Name {
+				return fmt.Errorf(lang.PkgValidateErrComponentDependsSelf, component.Name)
+			}
+			if _, ok := dependsOn[dependency]; !ok {
+				return fmt.Errorf(lang.PkgValidateErrComponentDependsMissing, component.Name, dependency)
+			}
+		}
+	}
+
+	// Walk the dependency graph depth first, a component that is reached again while still on the path is a cycle
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for idx, step := range path {
+				if step == name {
+					return fmt.Errorf(lang.PkgValidateErrComponentDependsCycle, strings.Join(append(path[idx:], name), " -> "))
+				}
+			}
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependsOn[name] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, component := range components {
+		if err := visit(component.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateYOLO(component types.ZarfComponent) error {
 	if len(component.Images) > 0 {
 		return fmt.Errorf(lang.PkgValidateErrYOLONoOCI)
@@ -142,6 +228,94 @@ func validateYOLO(component types.ZarfComponent) error {
 	return nil
 }
 
+func validateActions(actions types.ZarfComponentActions) error {
+	isAllCapsUnderscore := regexp.MustCompile(`^[A-Z0-9_]+$`).MatchString
+
+	for _, set := range []types.ZarfComponentActionSet{actions.OnCreate, actions.OnDeploy, actions.OnRemove} {
+		for _, action := range actionsInSet(set) {
+			if action.Cmd == "" {
+				return fmt.Errorf(lang.PkgValidateErrActionCmdMissing)
+			}
+			if action.MaxRetries < 0 || action.MaxTotalSeconds < 0 {
+				return fmt.Errorf(lang.PkgValidateErrActionLimits, action.Cmd)
+			}
+			if action.SetVariable != "" && !isAllCapsUnderscore(action.SetVariable) {
+				return fmt.Errorf(lang.PkgValidateErrPkgVariableName, action.SetVariable)
+			}
+		}
+	}
+
+	// Variables only exist for the lifetime of a deployment
+	for _, set := range []types.ZarfComponentActionSet{actions.OnCreate, actions.OnRemove} {
+		for _, action := range actionsInSet(set) {
+			if action.SetVariable != "" {
+				return fmt.Errorf(lang.PkgValidateErrActionSetVariable, action.Cmd)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDataInjection checks that a data injection targets either a PersistentVolumeClaim or a selector and container.
+func validateDataInjection(data types.ZarfDataInjection) error {
+	if data.Target.PVC != "" {
+		if data.Target.Selector != "" || data.Target.Container != "" {
+			return fmt.Errorf(lang.PkgValidateErrDataInjectionPVC, data.Target.PVC)
+		}
+	} else if data.Target.Selector == "" || data.Target.Container == "" {
+		return fmt.Errorf(lang.PkgValidateErrDataInjectionTarget, data.Target.Path)
+	}
+
+	if data.MaxTotalSeconds < 0 {
+		return fmt.Errorf(lang.PkgValidateErrDataInjectionTimeout, data.Target.Path)
+	}
+
+	return nil
+}
+
+// validateWait checks that a component wait has exactly one valid target.
+func validateWait(wait types.ZarfComponentWait) error {
+	if (wait.Cluster == nil) == (wait.Network == nil) {
+		return fmt.Errorf(lang.PkgValidateErrWaitTarget)
+	}
+
+	if wait.MaxTotalSeconds < 0 {
+		return fmt.Errorf(lang.PkgValidateErrWaitTimeout)
+	}
+
+	if wait.Cluster != nil {
+		if wait.Cluster.Kind == "" || wait.Cluster.Name == "" {
+			return fmt.Errorf(lang.PkgValidateErrWaitClusterMissing)
+		}
+		if wait.Cluster.Condition != "" && wait.Cluster.JSONPath != "" {
+			return fmt.Errorf(lang.PkgValidateErrWaitClusterCondition, wait.Cluster.Kind, wait.Cluster.Name)
+		}
+		if wait.Cluster.Value != "" && wait.Cluster.JSONPath == "" {
+			return fmt.Errorf(lang.PkgValidateErrWaitClusterValue, wait.Cluster.Kind, wait.Cluster.Name)
+		}
+	}
+
+	if wait.Network != nil {
+		switch wait.Network.Protocol {
+		case "tcp", "http", "https":
+		default:
+			return fmt.Errorf(lang.PkgValidateErrWaitNetworkProtocol, wait.Network.Protocol)
+		}
+		if wait.Network.Address == "" {
+			return fmt.Errorf(lang.PkgValidateErrWaitNetworkAddress)
+		}
+	}
+
+	return nil
+}
+
+func actionsInSet(set types.ZarfComponentActionSet) []types.ZarfComponentAction {
+	actions := append([]types.ZarfComponentAction{}, set.Before...)
+	actions = append(actions, set.After...)
+	return append(actions, set.OnFailure...)
+}
+
 func validatePackageName(subject string) error {
 	// https://regex101.com/r/vpi8a8/1
 	isValid := regexp.MustCompile(`^[a-z0-9\-]+$`).MatchString
@@ -153,6 +327,30 @@ func validatePackageName(subject string) error {
 	return nil
 }
 
+// validateVariablePath ensures the path of a variable is either a value path for every chart or names a chart in the
+// package as <component>/<chart>:<value path>.
+func validateVariablePath(subject types.ZarfPackageVariable, components []types.ZarfComponent) error {
+	target, valuePath, found := strings.Cut(subject.Path, ":")
+	if !found {
+		// Paths without a chart apply to every chart in the package
+		return nil
+	}
+
+	if target == "" || valuePath == "" {
+		return fmt.Errorf(lang.PkgValidateErrVariablePath, subject.Name, subject.Path)
+	}
+
+	for _, component := range components {
+		for _, chart := range component.Charts {
+			if strings.EqualFold(target, component.Name+"/"+chart.Name) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf(lang.PkgValidateErrVariablePathChart, subject.Name, target)
+}
+
 func validatePackageVariable(subject types.ZarfPackageVariable) error {
 	isAllCapsUnderscore := regexp.MustCompile(`^[A-Z0-9_]+$`).MatchString
 
@@ -161,6 +359,65 @@ func validatePackageVariable(subject types.ZarfPackageVariable) error {
 		return fmt.Errorf(lang.PkgValidateErrPkgVariableName, subject.Name)
 	}
 
+	switch subject.Type {
+	case "", "string", "int", "bool", "file":
+	default:
+		return fmt.Errorf(lang.PkgValidateErrVariableType, subject.Name, subject.Type)
+	}
+
+	if _, err := regexp.Compile(subject.Pattern); err != nil {
+		return fmt.Errorf(lang.PkgValidateErrVariablePattern, subject.Name, err)
+	}
+
+	if subject.AutoGenerate && subject.Type != "" && subject.Type != "string" {
+		return fmt.Errorf(lang.PkgValidateErrVariableAutoGenerate, subject.Name)
+	}
+
+	// generated values are random strings that are not checked against a pattern
+	if subject.AutoGenerate && subject.Pattern != "" {
+		return fmt.Errorf(lang.PkgValidateErrVariableGeneratePattern, subject.Name)
+	}
+
+	// the default of a file variable is a path on the machine deploying the package, so it can't be checked here
+	if subject.Default != "" && subject.Type != "file" {
+		if err := VariableValue(subject, subject.Default); err != nil {
+			return fmt.Errorf(lang.PkgValidateErrVariableDefault, subject.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// VariableValue validates a value of a package variable against its type and pattern. Empty values are only checked
+// against the pattern.
+func VariableValue(variable types.ZarfPackageVariable, value string) error {
+	if value != "" {
+		switch variable.Type {
+		case "int":
+			if _, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf(lang.PkgValidateErrVariableValueInt, variable.Name)
+			}
+		case "bool":
+			if _, err := strconv.ParseBool(value); err != nil {
+				return fmt.Errorf(lang.PkgValidateErrVariableValueBool, variable.Name)
+			}
+		case "file":
+			if info, err := os.Stat(value); err != nil || info.IsDir() {
+				return fmt.Errorf(lang.PkgValidateErrVariableValueFile, variable.Name)
+			}
+		}
+	}
+
+	if variable.Pattern != "" {
+		matched, err := regexp.MatchString(variable.Pattern, value)
+		if err != nil {
+			return fmt.Errorf(lang.PkgValidateErrVariablePattern, variable.Name, err)
+		}
+		if !matched {
+			return fmt.Errorf(lang.PkgValidateErrVariableValuePattern, variable.Name, variable.Pattern)
+		}
+	}
+
 	return nil
 }
 
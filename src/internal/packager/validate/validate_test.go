@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package validate provides Zarf package validation functions.
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDependencies(t *testing.T) {
+	component := func(name string, dependsOn ...string) types.ZarfComponent {
+		return types.ZarfComponent{Name: name, DependsOn: dependsOn}
+	}
+
+	tests := []struct {
+		name       string
+		components []types.ZarfComponent
+		err        string
+	}{
+		{
+			name:       "chain",
+			components: []types.ZarfComponent{component("a"), component("b", "a"), component("c", "a", "b")},
+		},
+		{
+			name:       "self",
+			components: []types.ZarfComponent{component("a", "a")},
+			err:        "component a cannot depend on itself",
+		},
+		{
+			name:       "missing",
+			components: []types.ZarfComponent{component("a", "b")},
+			err:        "component a depends on component b which does not exist",
+		},
+		{
+			name:       "cycle",
+			components: []types.ZarfComponent{component("a", "c"), component("b", "a"), component("c", "b")},
+			err:        "component dependency cycle detected: a -> c -> b -> a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDependencies(tt.components)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateVariablePath(t *testing.T) {
+	components := []types.ZarfComponent{
+		{Name: "app", Charts: []types.ZarfChart{{Name: "podinfo"}}},
+	}
+
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{path: ""},
+		{path: "app/podinfo:replicaCount"},
+		{path: "App/Podinfo:image.tag"},
+		{path: "replicaCount"},
+		{path: "app/podinfo:", err: "it must be <value path> or <component>/<chart>:<value path>"},
+		{path: ":replicaCount", err: "it must be <value path> or <component>/<chart>:<value path>"},
+		{path: "app/other:replicaCount", err: "there is no chart with that <component>/<chart> name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := validateVariablePath(types.ZarfPackageVariable{Name: "REPLICAS", Path: tt.path}, components)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateActions(t *testing.T) {
+	onDeploy := func(actions ...types.ZarfComponentAction) types.ZarfComponentActions {
+		return types.ZarfComponentActions{OnDeploy: types.ZarfComponentActionSet{Before: actions}}
+	}
+
+	tests := []struct {
+		name    string
+		actions types.ZarfComponentActions
+		err     string
+	}{
+		{
+			name:    "valid",
+			actions: onDeploy(types.ZarfComponentAction{Cmd: "echo meow", MaxRetries: 2, MaxTotalSeconds: 10, SetVariable: "CAT_SOUND"}),
+		},
+		{
+			name:    "missing cmd",
+			actions: onDeploy(types.ZarfComponentAction{Dir: "somewhere"}),
+			err:     "action must include a cmd",
+		},
+		{
+			name:    "negative retries",
+			actions: onDeploy(types.ZarfComponentAction{Cmd: "echo meow", MaxRetries: -1}),
+			err:     "cannot have a negative maxRetries or maxTotalSeconds",
+		},
+		{
+			name:    "invalid variable name",
+			actions: onDeploy(types.ZarfComponentAction{Cmd: "echo meow", SetVariable: "cat-sound"}),
+			err:     "variable name 'cat-sound' must be all uppercase",
+		},
+		{
+			name: "set variable on remove",
+			actions: types.ZarfComponentActions{OnRemove: types.ZarfComponentActionSet{
+				OnFailure: []types.ZarfComponentAction{{Cmd: "echo meow", SetVariable: "CAT_SOUND"}},
+			}},
+			err: "can only set a variable in onDeploy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateActions(tt.actions)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidatePackageVariable(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable types.ZarfPackageVariable
+		err      string
+	}{
+		{name: "plain", variable: types.ZarfPackageVariable{Name: "PLAIN"}},
+		{name: "lowercase name", variable: types.ZarfPackageVariable{Name: "lower"}, err: "must be all uppercase"},
+		{name: "unknown type", variable: types.ZarfPackageVariable{Name: "FLOAT", Type: "float"}, err: "has an invalid type float"},
+		{name: "invalid pattern", variable: types.ZarfPackageVariable{Name: "PATTERN", Pattern: "["}, err: "has an invalid pattern"},
+		{name: "int default", variable: types.ZarfPackageVariable{Name: "PORT", Type: "int", Default: "8080"}},
+		{name: "invalid int default", variable: types.ZarfPackageVariable{Name: "PORT", Type: "int", Default: "http"}, err: "default value of variable PORT is invalid"},
+		{name: "invalid bool default", variable: types.ZarfPackageVariable{Name: "DEBUG", Type: "bool", Default: "yes"}, err: "default value of variable DEBUG is invalid"},
+		{name: "default not matching the pattern", variable: types.ZarfPackageVariable{Name: "USER", Pattern: "^[a-z]+$", Default: "Admin"}, err: "default value of variable USER is invalid"},
+		{name: "file default is not checked", variable: types.ZarfPackageVariable{Name: "CONFIG", Type: "file", Default: "does-not-exist.yaml"}},
+		{name: "auto-generated string", variable: types.ZarfPackageVariable{Name: "PASSWORD", AutoGenerate: true}},
+		{name: "auto-generated int", variable: types.ZarfPackageVariable{Name: "PASSWORD", Type: "int", AutoGenerate: true}, err: "can only be auto-generated if its type is string"},
+		{name: "auto-generated with a pattern", variable: types.ZarfPackageVariable{Name: "PASSWORD", Pattern: ".{8,}", AutoGenerate: true}, err: "can not have a pattern since it is auto-generated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePackageVariable(tt.variable)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestVariableValue(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "values.yaml")
+	assert.NoError(t, os.WriteFile(file, []byte("key: value"), 0600))
+
+	tests := []struct {
+		name     string
+		variable types.ZarfPackageVariable
+		value    string
+		err      string
+	}{
+		{name: "string", variable: types.ZarfPackageVariable{Name: "NAME"}, value: "anything"},
+		{name: "int", variable: types.ZarfPackageVariable{Name: "PORT", Type: "int"}, value: "8080"},
+		{name: "not an int", variable: types.ZarfPackageVariable{Name: "PORT", Type: "int"}, value: "80.5", err: "value of variable PORT must be an int"},
+		{name: "bool", variable: types.ZarfPackageVariable{Name: "DEBUG", Type: "bool"}, value: "false"},
+		{name: "not a bool", variable: types.ZarfPackageVariable{Name: "DEBUG", Type: "bool"}, value: "no", err: "value of variable DEBUG must be a bool"},
+		{name: "file", variable: types.ZarfPackageVariable{Name: "CONFIG", Type: "file"}, value: file},
+		{name: "missing file", variable: types.ZarfPackageVariable{Name: "CONFIG", Type: "file"}, value: file + ".missing", err: "must be the path of an existing file"},
+		{name: "directory", variable: types.ZarfPackageVariable{Name: "CONFIG", Type: "file"}, value: filepath.Dir(file), err: "must be the path of an existing file"},
+		{name: "empty value skips the type", variable: types.ZarfPackageVariable{Name: "PORT", Type: "int"}, value: ""},
+		{name: "matches the pattern", variable: types.ZarfPackageVariable{Name: "USER", Pattern: "^[a-z]+$"}, value: "admin"},
+		{name: "does not match the pattern", variable: types.ZarfPackageVariable{Name: "USER", Pattern: "^[a-z]+$"}, value: "Admin", err: "does not match the pattern ^[a-z]+$"},
+		{name: "empty value is checked against the pattern", variable: types.ZarfPackageVariable{Name: "USER", Pattern: "^[a-z]+$"}, value: "", err: "does not match the pattern"},
+		{name: "type and pattern", variable: types.ZarfPackageVariable{Name: "PORT", Type: "int", Pattern: "^80[0-9]{2}$"}, value: "9090", err: "does not match the pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VariableValue(tt.variable, tt.value)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
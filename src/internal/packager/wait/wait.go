@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package wait provides functions for waiting on cluster resources and network endpoints.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/pkg/k8s"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// DefaultTimeoutSeconds is how long to wait if a wait does not set maxTotalSeconds.
+const DefaultTimeoutSeconds = 300
+
+// For waits (with a spinner) for the given cluster resource or network endpoint until it is ready or the wait times out.
+func For(wait types.ZarfComponentWait) error {
+	message.Debugf("wait.For(%#v)", wait)
+
+	if wait.MaxTotalSeconds < 1 {
+		wait.MaxTotalSeconds = DefaultTimeoutSeconds
+	}
+
+	description := Describe(wait)
+	spinner := message.NewProgressSpinner("Waiting for %s (timeout: %d seconds)", description, wait.MaxTotalSeconds)
+	defer spinner.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(wait.MaxTotalSeconds)*time.Second)
+	defer cancel()
+
+	var err error
+	switch {
+	case wait.Cluster != nil:
+		var kube *k8s.K8s
+		if kube, err = k8s.New(message.Debugf, nil); err != nil {
+			return err
+		}
+		err = kube.WaitForResource(ctx, wait.Cluster.Kind, wait.Cluster.Name, wait.Cluster.Namespace,
+			wait.Cluster.Condition, wait.Cluster.JSONPath, wait.Cluster.Value)
+
+	case wait.Network != nil:
+		err = utils.WaitForNetworkEndpoint(ctx, wait.Network.Protocol, wait.Network.Address, wait.Network.Code)
+
+	default:
+		return fmt.Errorf("a wait must include either a cluster resource or a network endpoint")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	spinner.Successf("%s is ready", description)
+	return nil
+}
+
+// Describe returns a short human readable description of the given wait.
+func Describe(wait types.ZarfComponentWait) string {
+	if wait.Cluster != nil {
+		description := fmt.Sprintf("%s %s", wait.Cluster.Kind, wait.Cluster.Name)
+		if wait.Cluster.Namespace != "" {
+			description += fmt.Sprintf(" in namespace %s", wait.Cluster.Namespace)
+		}
+		switch {
+		case wait.Cluster.Condition != "":
+			description += fmt.Sprintf(" to be %s", wait.Cluster.Condition)
+		case wait.Cluster.JSONPath != "" && wait.Cluster.Value != "":
+			description += fmt.Sprintf(" to have %s=%s", wait.Cluster.JSONPath, wait.Cluster.Value)
+		case wait.Cluster.JSONPath != "":
+			description += fmt.Sprintf(" to have %s", wait.Cluster.JSONPath)
+		}
+		return description
+	}
+
+	if wait.Network != nil {
+		return fmt.Sprintf("%s endpoint %s", wait.Network.Protocol, wait.Network.Address)
+	}
+
+	return "nothing"
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package k8s provides a client for interacting with a Kubernetes cluster.
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+)
+
+// RestartDeployment triggers a rolling restart of a deployment the same way kubectl rollout restart does.
+func (k *K8s) RestartDeployment(namespace, name string) error {
+	k.Log("k8s.RestartDeployment(%s, %s)", namespace, name)
+
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`, time.Now().Format(time.RFC3339))
+	_, err := k.Clientset.AppsV1().Deployments(namespace).Patch(context.TODO(), name, k8sTypes.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
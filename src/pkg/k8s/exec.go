@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package k8s provides a client for interacting with a Kubernetes cluster.
+package k8s
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// closingUpgrader keeps track of the SPDY connection of an exec so it can be closed when the exec is canceled.
+type closingUpgrader struct {
+	spdy.Upgrader
+
+	mutex  sync.Mutex
+	conn   httpstream.Connection
+	closed bool
+}
+
+// NewConnection upgrades the response to a SPDY connection, closing it right away if the exec was already canceled.
+func (u *closingUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := u.Upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	if u.closed {
+		conn.Close()
+		return nil, errors.New("the exec was canceled")
+	}
+	u.conn = conn
+	return conn, nil
+}
+
+// close closes the connection of the exec (if there is one yet) and any connection made after.
+func (u *closingUpgrader) close() {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.closed = true
+	if u.conn != nil {
+		u.conn.Close()
+	}
+}
+
+// ExecInPod runs a command in a container of a pod over a SPDY stream (the same way kubectl exec does), passing it the given
+// stdin and writing its output to the given stdout and stderr. It returns once the command exits or the context is done, in which
+// case the connection is closed and a stdin pipe is closed with the context error so whatever is writing to it stops.
+func (k *K8s) ExecInPod(ctx context.Context, namespace, pod, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	k.Log("k8s.ExecInPod(%s, %s, %s, %v)", namespace, pod, container, command)
+
+	req := k.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+		}, scheme.ParameterCodec)
+
+	transport, upgrader, err := spdy.RoundTripperFor(k.RestConfig)
+	if err != nil {
+		return err
+	}
+
+	// This version of client-go can't cancel a stream, so hold on to its connection to close it instead
+	closer := &closingUpgrader{Upgrader: upgrader}
+	executor, err := remotecommand.NewSPDYExecutorForTransports(transport, closer, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- executor.Stream(remotecommand.StreamOptions{
+			Stdin:  stdin,
+			Stdout: stdout,
+			Stderr: stderr,
+		})
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if pipe, ok := stdin.(*io.PipeReader); ok {
+			_ = pipe.CloseWithError(ctx.Err())
+		}
+		closer.close()
+		return ctx.Err()
+	}
+}
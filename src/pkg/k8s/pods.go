@@ -60,6 +60,11 @@ func (k *K8s) CreatePod(pod *corev1.Pod) (*corev1.Pod, error) {
 	return k.Clientset.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, createOptions)
 }
 
+// GetPod returns a pod from the cluster by namespace & name.
+func (k *K8s) GetPod(namespace, name string) (*corev1.Pod, error) {
+	return k.Clientset.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
 // GetAllPods returns a list of pods from the cluster for all namespaces.
 func (k *K8s) GetAllPods() (*corev1.PodList, error) {
 	return k.GetPods(corev1.NamespaceAll)
@@ -73,11 +78,11 @@ func (k *K8s) GetPods(namespace string) (*corev1.PodList, error) {
 
 // WaitForPodsAndContainers attempts to find pods matching the given selector and optional inclusion filter
 // It will wait up to 90 seconds for the pods to be found and will return a list of matching pod names
-// If the timeout is reached, an empty list will be returned.
-func (k *K8s) WaitForPodsAndContainers(target PodLookup, include PodFilter) []string {
+// If the timeout is reached or the context is done, an empty list will be returned.
+func (k *K8s) WaitForPodsAndContainers(ctx context.Context, target PodLookup, include PodFilter) []string {
 	for count := 0; count < waitLimit; count++ {
 
-		pods, err := k.Clientset.CoreV1().Pods(target.Namespace).List(context.TODO(), metav1.ListOptions{
+		pods, err := k.Clientset.CoreV1().Pods(target.Namespace).List(ctx, metav1.ListOptions{
 			LabelSelector: target.Selector,
 		})
 		if err != nil {
@@ -148,7 +153,12 @@ func (k *K8s) WaitForPodsAndContainers(target PodLookup, include PodFilter) []st
 			}
 		}
 
-		time.Sleep(3 * time.Second)
+		select {
+		case <-ctx.Done():
+			k.Log("Pod lookup canceled: %s", ctx.Err())
+			return []string{}
+		case <-time.After(3 * time.Second):
+		}
 	}
 
 	k.Log("Pod lookup timeout exceeded")
@@ -27,6 +27,12 @@ func (k *K8s) GetSecretsWithLabel(namespace, labelSelector string) (*corev1.Secr
 
 // GenerateSecret returns a Kubernetes secret object without applying it to the cluster.
 func (k *K8s) GenerateSecret(namespace, name string, secretType corev1.SecretType) *corev1.Secret {
+	// Copy the default labels so labels added to one secret do not end up on the next
+	secretLabels := make(Labels, len(k.Labels))
+	for key, value := range k.Labels {
+		secretLabels[key] = value
+	}
+
 	return &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
@@ -35,7 +41,7 @@ func (k *K8s) GenerateSecret(namespace, name string, secretType corev1.SecretTyp
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
-			Labels:    k.Labels,
+			Labels:    secretLabels,
 		},
 		Type: secretType,
 		Data: map[string][]byte{},
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package k8s provides a client for interacting with a Kubernetes cluster.
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGenerateSecretCopiesLabels(t *testing.T) {
+	k := &K8s{Labels: Labels{"app.kubernetes.io/managed-by": "zarf"}}
+
+	first := k.GenerateSecret("zarf", "first", corev1.SecretTypeOpaque)
+	first.Labels["package-deploy-info"] = "first"
+
+	second := k.GenerateSecret("zarf", "second", corev1.SecretTypeOpaque)
+	assert.Equal(t, map[string]string{"app.kubernetes.io/managed-by": "zarf"}, second.Labels)
+	assert.Equal(t, Labels{"app.kubernetes.io/managed-by": "zarf"}, k.Labels)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package k8s provides a client for interacting with a Kubernetes cluster.
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// WaitForResource checks every second until the context is done for the resources of the given kind with the given name (or
+// matching the given label selector, e.g. app=podinfo) to exist and, if given, to have the given status condition set to True
+// or the given value at the given JSONPath.
+func (k *K8s) WaitForResource(ctx context.Context, kind, name, namespace, condition, jsonPath, value string) error {
+	for {
+		err := k.checkResource(ctx, kind, name, namespace, condition, jsonPath, value)
+		if err == nil {
+			return nil
+		}
+		k.Log("%s %s is not ready yet: %s", kind, name, err.Error())
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s %s: %w", kind, name, err)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (k *K8s) checkResource(ctx context.Context, kind, name, namespace, condition, jsonPath, value string) error {
+	// Resolve the kind the same way kubectl does, so plurals, short names and groups (e.g. deploy or helmreleases.helm.toolkit.fluxcd.io) work.
+	// The discovery data is not cached between checks, since the CRD for the kind may only be installed while waiting.
+	discoveryClient := memory.NewMemCacheClient(k.Clientset.Discovery())
+	mapper := restmapper.NewShortcutExpander(restmapper.NewDeferredDiscoveryRESTMapper(discoveryClient), discoveryClient)
+
+	groupResource := schema.ParseGroupResource(strings.ToLower(kind))
+	gvk, err := mapper.KindFor(groupResource.WithVersion(""))
+	if err != nil {
+		return err
+	}
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(k.RestConfig)
+	if err != nil {
+		return err
+	}
+
+	var resourceClient dynamic.ResourceInterface = dynamicClient.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if namespace == "" {
+			namespace = metav1.NamespaceDefault
+		}
+		resourceClient = dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+	}
+
+	var resources []unstructured.Unstructured
+	if strings.Contains(name, "=") {
+		list, err := resourceClient.List(ctx, metav1.ListOptions{LabelSelector: name})
+		if err != nil {
+			return err
+		}
+		if len(list.Items) < 1 {
+			return fmt.Errorf("no %s matching %s found", kind, name)
+		}
+		resources = list.Items
+	} else {
+		resource, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		resources = append(resources, *resource)
+	}
+
+	for _, resource := range resources {
+		if err := checkResourceState(resource, condition, jsonPath, value); err != nil {
+			return fmt.Errorf("%s %s: %w", kind, resource.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func checkResourceState(resource unstructured.Unstructured, condition, jsonPath, value string) error {
+	if condition != "" {
+		conditions, _, err := unstructured.NestedSlice(resource.Object, "status", "conditions")
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range conditions {
+			if c, ok := entry.(map[string]interface{}); ok {
+				if strings.EqualFold(fmt.Sprint(c["type"]), condition) && fmt.Sprint(c["status"]) == string(metav1.ConditionTrue) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("condition %s is not True", condition)
+	}
+
+	if jsonPath != "" {
+		// Accept both {.status.phase} and .status.phase
+		if !strings.HasPrefix(jsonPath, "{") {
+			jsonPath = fmt.Sprintf("{%s}", jsonPath)
+		}
+
+		parser := jsonpath.New("wait")
+		if err := parser.Parse(jsonPath); err != nil {
+			return fmt.Errorf("invalid JSONPath %s: %w", jsonPath, err)
+		}
+
+		var buf bytes.Buffer
+		if err := parser.Execute(&buf, resource.Object); err != nil {
+			return err
+		}
+
+		actual := strings.TrimSpace(buf.String())
+		if value == "" && actual == "" {
+			return fmt.Errorf("%s is not set", jsonPath)
+		}
+		if value != "" && actual != value {
+			return fmt.Errorf("%s is %q, not %q", jsonPath, actual, value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package k8s provides a client for interacting with a Kubernetes cluster.
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCheckResourceState(t *testing.T) {
+	pod := unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"podIP": "",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Initialized", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "False"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name      string
+		condition string
+		jsonPath  string
+		value     string
+		err       string
+	}{
+		{name: "exists"},
+		{name: "condition true", condition: "initialized"},
+		{name: "condition false", condition: "Ready", err: "condition Ready is not True"},
+		{name: "condition missing", condition: "Available", err: "condition Available is not True"},
+		{name: "jsonpath value", jsonPath: "{.status.phase}", value: "Running"},
+		{name: "jsonpath without braces", jsonPath: ".status.phase", value: "Running"},
+		{name: "jsonpath set", jsonPath: ".status.phase"},
+		{name: "jsonpath wrong value", jsonPath: ".status.phase", value: "Pending", err: `{.status.phase} is "Running", not "Pending"`},
+		{name: "jsonpath empty", jsonPath: ".status.podIP", err: "{.status.podIP} is not set"},
+		{name: "jsonpath missing", jsonPath: ".status.hostIP", err: "hostIP is not found"},
+		{name: "jsonpath invalid", jsonPath: "{.status[", err: "invalid JSONPath"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkResourceState(pod, tt.condition, tt.jsonPath, tt.value)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/internal/packager/validate"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// runActions runs the given component actions in order, stopping at the first one that fails.
+func (p *Packager) runActions(actions []types.ZarfComponentAction) error {
+	for _, action := range actions {
+		if err := p.runAction(action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runSetVariableActions runs only the before and after actions that set a variable, for a component that is not deployed again.
+func (p *Packager) runSetVariableActions(actions types.ZarfComponentActionSet) error {
+	for _, stage := range [][]types.ZarfComponentAction{actions.Before, actions.After} {
+		for _, action := range stage {
+			if action.SetVariable == "" {
+				continue
+			}
+			if err := p.runAction(action); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// runFailureActions runs the onFailure actions of a stage that already failed, so their own failures are only reported.
+func (p *Packager) runFailureActions(actions []types.ZarfComponentAction) {
+	for _, action := range actions {
+		if err := p.runAction(action); err != nil {
+			message.Errorf(err, "Unable to run the onFailure action \"%s\"", action.Cmd)
+		}
+	}
+}
+
+// runAction runs a single action until it succeeds, runs out of retries or runs out of time.
+func (p *Packager) runAction(action types.ZarfComponentAction) error {
+	message.Debugf("packager.runAction(%#v)", action)
+
+	cmd, err := p.scriptMutation(action.Cmd)
+	if err != nil {
+		return fmt.Errorf("unable to prepare the action \"%s\": %w", action.Cmd, err)
+	}
+
+	spinner := message.NewProgressSpinner("Running \"%s\"", cmd)
+	defer spinner.Stop()
+
+	ctx := context.Background()
+	if action.MaxTotalSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(action.MaxTotalSeconds)*time.Second)
+		defer cancel()
+	}
+
+	// Expose the variables set so far (including those set by earlier actions) to the command
+	env := make([]string, 0, len(p.cfg.SetVariableMap)+len(action.Env))
+	for name, value := range p.cfg.SetVariableMap {
+		env = append(env, fmt.Sprintf("ZARF_VAR_%s=%s", name, value))
+	}
+	env = append(env, action.Env...)
+
+	shell, shellArgs := getShell()
+
+	for attempt := 0; attempt <= action.MaxRetries; attempt++ {
+		if attempt > 0 {
+			spinner.Updatef("Retrying \"%s\" (retry %d of %d)", cmd, attempt, action.MaxRetries)
+		}
+
+		var stdout, stderr string
+		stdout, stderr, err = utils.ExecCommandWithContextDirAndEnv(ctx, action.Dir, env, !action.Mute, shell, shellArgs, cmd)
+		if err == nil {
+			if action.SetVariable != "" {
+				if err := p.setVariableFromAction(action.SetVariable, strings.TrimSpace(stdout)); err != nil {
+					return err
+				}
+			}
+
+			spinner.Successf("Completed \"%s\"", cmd)
+			return nil
+		}
+
+		message.Debug(err, stderr)
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("action \"%s\" timed out after %d seconds", cmd, action.MaxTotalSeconds)
+		}
+	}
+
+	return fmt.Errorf("action \"%s\" failed after %d attempt(s): %w", cmd, action.MaxRetries+1, err)
+}
+
+// setVariableFromAction sets a variable to the output of an action so that it can be used by later templating.
+func (p *Packager) setVariableFromAction(name string, value string) error {
+	// Values for declared variables still have to be valid
+	for _, variable := range p.cfg.Pkg.Variables {
+		if variable.Name == name && variable.Type != "file" {
+			if err := validate.VariableValue(variable, value); err != nil {
+				return fmt.Errorf("unable to set the variable from the action output: %w", err)
+			}
+		}
+	}
+
+	p.cfg.SetVariableMap[name] = value
+	return nil
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+)
+
+// generateChecksums writes the sha256 of every file in the package to checksums.txt and records the
+// checksum of checksums.txt itself in the zarf.yaml so that signing the zarf.yaml covers every file.
+func (p *Packager) generateChecksums() error {
+	message.Debug("packager.generateChecksums()")
+
+	files, err := utils.RecursiveFileList(p.tmp.Base, nil)
+	if err != nil {
+		return fmt.Errorf("unable to list the package contents: %w", err)
+	}
+
+	var lines []string
+	for _, file := range files {
+		rel, err := filepath.Rel(p.tmp.Base, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if isChecksumExempt(rel) {
+			continue
+		}
+
+		sum, err := utils.GetSha256Sum(file)
+		if err != nil {
+			return fmt.Errorf("unable to generate the checksum of %s: %w", rel, err)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", sum, rel))
+	}
+	sort.Strings(lines)
+
+	checksumsPath := filepath.Join(p.tmp.Base, config.ZarfChecksumsTxt)
+	if err := os.WriteFile(checksumsPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return fmt.Errorf("unable to write %s: %w", config.ZarfChecksumsTxt, err)
+	}
+
+	aggregate, err := utils.GetSha256Sum(checksumsPath)
+	if err != nil {
+		return err
+	}
+	p.cfg.Pkg.Build.AggregateChecksum = aggregate
+
+	// The zarf.yaml is written read-only, so remove it before writing the updated build data
+	_ = os.Remove(p.tmp.ZarfYaml)
+	return utils.WriteYaml(p.tmp.ZarfYaml, p.cfg.Pkg, 0400)
+}
+
+// validateChecksums checks the files in the package against checksums.txt and checksums.txt against the
+// aggregate checksum in the zarf.yaml. Files that were not pulled (e.g. the components left out of a partial
+// OCI pull) are only allowed to be missing if allowMissing is set.
+func (p *Packager) validateChecksums(allowMissing bool) error {
+	message.Debugf("packager.validateChecksums(%t)", allowMissing)
+
+	checksumsPath := filepath.Join(p.tmp.Base, config.ZarfChecksumsTxt)
+	aggregate, err := utils.GetSha256Sum(checksumsPath)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %w", config.ZarfChecksumsTxt, err)
+	}
+	if aggregate != p.cfg.Pkg.Build.AggregateChecksum {
+		return fmt.Errorf("%s does not match the aggregate checksum in the zarf.yaml", config.ZarfChecksumsTxt)
+	}
+
+	file, err := os.Open(checksumsPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	expected := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		sum, rel, ok := strings.Cut(line, " ")
+		if !ok {
+			return fmt.Errorf("invalid line in %s: %q", config.ZarfChecksumsTxt, line)
+		}
+		expected[rel] = true
+
+		path := filepath.Join(p.tmp.Base, filepath.FromSlash(rel))
+		if !strings.HasPrefix(path, p.tmp.Base) {
+			return fmt.Errorf("invalid path in %s: %s", config.ZarfChecksumsTxt, rel)
+		}
+
+		if utils.InvalidPath(path) {
+			if allowMissing {
+				continue
+			}
+			return fmt.Errorf("the package is missing %s", rel)
+		}
+
+		actual, err := utils.GetSha256Sum(path)
+		if err != nil {
+			return err
+		}
+		if actual != sum {
+			return fmt.Errorf("the checksum of %s does not match %s", rel, config.ZarfChecksumsTxt)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read %s: %w", config.ZarfChecksumsTxt, err)
+	}
+
+	// Make sure nothing was added to the package after it was created
+	files, err := utils.RecursiveFileList(p.tmp.Base, nil)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		rel, err := filepath.Rel(p.tmp.Base, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !isChecksumExempt(rel) && !expected[rel] {
+			return fmt.Errorf("%s is not listed in %s", rel, config.ZarfChecksumsTxt)
+		}
+	}
+
+	return nil
+}
+
+// isChecksumExempt returns true for the files that cannot be listed in checksums.txt, since they contain
+// (or sign) the checksum of checksums.txt.
+func isChecksumExempt(rel string) bool {
+	return rel == config.ZarfYAML || rel == config.ZarfChecksumsTxt || rel == config.ZarfYAMLSignature
+}
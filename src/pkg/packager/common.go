@@ -29,6 +29,12 @@ type Packager struct {
 	cluster *cluster.Cluster
 	tmp     types.TempPaths
 	arch    string
+
+	// downloadDir holds a remote package while it is loaded
+	downloadDir string
+
+	// differentialBaseImages are the images (by component) a differential package left out because its base package deployed them
+	differentialBaseImages map[string][]string
 }
 
 /*
@@ -115,6 +121,9 @@ func (p *Packager) ClearTempPaths() {
 	// Remove the temp directory, but don't throw an error if it fails
 	_ = os.RemoveAll(p.tmp.Base)
 	_ = os.RemoveAll(config.ZarfSBOMDir)
+	if p.downloadDir != "" {
+		_ = os.RemoveAll(p.downloadDir)
+	}
 }
 
 func (p *Packager) createComponentPaths(component types.ZarfComponent) (paths types.ComponentPaths, err error) {
@@ -155,7 +164,7 @@ func createPaths() (paths types.TempPaths, err error) {
 
 		InjectBinary: filepath.Join(basePath, "zarf-injector"),
 		SeedImage:    filepath.Join(basePath, "seed-image.tar"),
-		Images:       filepath.Join(basePath, "images.tar"),
+		Images:       filepath.Join(basePath, "images"),
 		Components:   filepath.Join(basePath, "components"),
 		Sboms:        filepath.Join(basePath, "sboms"),
 		ZarfYaml:     filepath.Join(basePath, config.ZarfYAML),
@@ -176,24 +185,45 @@ func (p *Packager) loadZarfPkg() error {
 	spinner := message.NewProgressSpinner("Loading Zarf Package %s", p.cfg.DeployOpts.PackagePath)
 	defer spinner.Stop()
 
-	if err := p.handlePackagePath(); err != nil {
-		return fmt.Errorf("unable to handle the provided package path: %w", err)
-	}
+	if IsOCIURL(p.cfg.DeployOpts.PackagePath) {
+		// OCI packages are pulled layer by layer directly into the temp directory
+		spinner.Updatef("Pulling the package from the OCI registry, this may take a few moments")
+		if err := p.handleOCIPackage(); err != nil {
+			return fmt.Errorf("unable to pull the package: %w", err)
+		}
+	} else {
+		if err := p.handlePackagePath(); err != nil {
+			return fmt.Errorf("unable to handle the provided package path: %w", err)
+		}
 
-	// Make sure the user gave us a package we can work with
-	if utils.InvalidPath(p.cfg.DeployOpts.PackagePath) {
-		return fmt.Errorf("unable to find the package at %s", p.cfg.DeployOpts.PackagePath)
-	}
+		// Make sure the user gave us a package we can work with
+		if utils.InvalidPath(p.cfg.DeployOpts.PackagePath) {
+			return fmt.Errorf("unable to find the package at %s", p.cfg.DeployOpts.PackagePath)
+		}
 
-	// If packagePath has partial in the name, we need to combine the partials into a single package
-	if err := p.handleIfPartialPkg(); err != nil {
-		return fmt.Errorf("unable to process partial package: %w", err)
-	}
+		// If packagePath has partial in the name, we need to combine the partials into a single package
+		if err := p.handleIfPartialPkg(); err != nil {
+			return fmt.Errorf("unable to process partial package: %w", err)
+		}
 
-	// Extract the archive
-	spinner.Updatef("Extracting the package, this may take a few moments")
-	if err := archiver.Unarchive(p.cfg.DeployOpts.PackagePath, p.tmp.Base); err != nil {
-		return fmt.Errorf("unable to extract the package: %w", err)
+		// Verify the signature before anything else is extracted from the package
+		if p.cfg.DeployOpts.PublicKeyPath != "" {
+			spinner.Updatef("Verifying the package signature")
+			if err := p.verifyPackageArchive(); err != nil {
+				return err
+			}
+		}
+
+		// Extract the archive
+		spinner.Updatef("Extracting the package, this may take a few moments")
+		if err := archiver.Unarchive(p.cfg.DeployOpts.PackagePath, p.tmp.Base); err != nil {
+			return fmt.Errorf("unable to extract the package: %w", err)
+		}
+
+		// Check the extracted zarf.yaml as well in case the archive holds more than one copy of it
+		if err := p.validatePackageSignature(p.tmp.Base); err != nil {
+			return err
+		}
 	}
 
 	// Load the config from the extracted archive zarf.yaml
@@ -203,6 +233,19 @@ func (p *Packager) loadZarfPkg() error {
 		return fmt.Errorf("unable to read the zarf.yaml in %s: %w", p.tmp.Base, err)
 	}
 
+	// Make sure none of the files were corrupted or changed since the package was created
+	if p.cfg.Pkg.Build.AggregateChecksum != "" {
+		spinner.Updatef("Validating the package checksums")
+		if err := p.validateChecksums(IsOCIURL(p.cfg.DeployOpts.PackagePath)); err != nil {
+			return fmt.Errorf("unable to validate the package checksums: %w", err)
+		}
+	} else if p.cfg.DeployOpts.PublicKeyPath != "" {
+		// A verified signature only covers the other files through the checksums in the zarf.yaml
+		return fmt.Errorf("the package does not contain a %s, its contents cannot be verified", config.ZarfChecksumsTxt)
+	} else {
+		message.Debugf("The package does not contain a %s, skipping checksum validation", config.ZarfChecksumsTxt)
+	}
+
 	// If SBOM files exist, temporarily place them in the deploy directory
 	if err := sbom.OutputSBOMFiles(p.tmp, config.ZarfSBOMDir, ""); err != nil {
 		// Don't stop the deployment, let the user decide if they want to continue the deployment
@@ -213,6 +256,51 @@ func (p *Packager) loadZarfPkg() error {
 	return nil
 }
 
+// verifyPackageArchive extracts only the zarf.yaml and its signature from the package archive and verifies them.
+func (p *Packager) verifyPackageArchive() error {
+	message.Debugf("packager.verifyPackageArchive(%s)", p.cfg.DeployOpts.PackagePath)
+
+	tmpDir, err := utils.MakeTempDir(config.CommonOptions.TempDirectory)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for _, file := range []string{config.ZarfYAML, config.ZarfYAMLSignature} {
+		if err := archiver.Extract(p.cfg.DeployOpts.PackagePath, file, tmpDir); err != nil {
+			return fmt.Errorf("unable to extract %s from the package: %w", file, err)
+		}
+	}
+
+	return p.validatePackageSignature(tmpDir)
+}
+
+// validatePackageSignature verifies the zarf.yaml in the given directory against its signature using the public key
+// passed to deploy/inspect. If no key was given, signed packages only produce a warning.
+func (p *Packager) validatePackageSignature(dir string) error {
+	message.Debugf("packager.validatePackageSignature(%s)", dir)
+
+	signaturePath := filepath.Join(dir, config.ZarfYAMLSignature)
+	signed := !utils.InvalidPath(signaturePath)
+
+	if p.cfg.DeployOpts.PublicKeyPath == "" {
+		if signed {
+			message.Warn("The package is signed but no public key was provided (--key), its signature will not be verified")
+		}
+		return nil
+	}
+
+	if !signed {
+		return fmt.Errorf("a public key was provided but the package is not signed")
+	}
+
+	if err := utils.CosignVerifyBlob(filepath.Join(dir, config.ZarfYAML), signaturePath, p.cfg.DeployOpts.PublicKeyPath); err != nil {
+		return fmt.Errorf("unable to verify the package signature: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Packager) handleIfPartialPkg() error {
 	message.Debugf("Checking for partial package: %s", p.cfg.DeployOpts.PackagePath)
 
@@ -94,9 +94,104 @@ func (p *Packager) getValidComponents() []types.ZarfComponent {
 		message.Fatalf(err, "Invalid component argument, %s", err)
 	}
 
+	// Pull in any dependencies of the selected components and order them so dependencies deploy first
+	validComponentsList, err := resolveDependencies(p.cfg.Pkg.Components, validComponentsList)
+	if err != nil {
+		message.Fatalf(err, "Unable to resolve component dependencies, %s", err)
+	}
+
 	return validComponentsList
 }
 
+// resolveDependencies adds the dependencies of the selected components and orders the result so that every component
+// comes after the components it depends on (otherwise preserving the order of the package).
+func resolveDependencies(components []types.ZarfComponent, selectedComponents []types.ZarfComponent) ([]types.ZarfComponent, error) {
+	message.Debugf("packager.resolveDependencies(%#v)", selectedComponents)
+
+	packageComponents := make(map[string]types.ZarfComponent)
+	for _, component := range components {
+		packageComponents[component.Name] = component
+	}
+
+	included := make(map[string]bool)
+	chosenFromGroup := make(map[string]string)
+	for _, component := range selectedComponents {
+		included[component.Name] = true
+		if component.Group != "" {
+			chosenFromGroup[component.Group] = component.Name
+		}
+	}
+
+	var include func(component types.ZarfComponent) error
+	include = func(component types.ZarfComponent) error {
+		for _, name := range component.DependsOn {
+			if included[name] {
+				continue
+			}
+
+			dependency, ok := packageComponents[name]
+			if !ok {
+				return fmt.Errorf("component %s depends on component %s which is not in this package", component.Name, name)
+			}
+
+			// Only one component from a choice group may be deployed
+			if dependency.Group != "" {
+				if chosen, ok := chosenFromGroup[dependency.Group]; ok {
+					return fmt.Errorf("component %s depends on component %s but %s was chosen from the group %s", component.Name, name, chosen, dependency.Group)
+				}
+				chosenFromGroup[dependency.Group] = name
+			}
+
+			message.Notef("Including component %s as a dependency of %s", name, component.Name)
+			included[name] = true
+
+			if err := include(dependency); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, component := range selectedComponents {
+		if err := include(component); err != nil {
+			return nil, err
+		}
+	}
+
+	// Repeatedly take the first component (in package order) whose dependencies have all been placed
+	var orderedComponents []types.ZarfComponent
+	placed := make(map[string]bool)
+	for len(orderedComponents) < len(included) {
+		progress := false
+		for _, component := range components {
+			if !included[component.Name] || placed[component.Name] {
+				continue
+			}
+
+			ready := true
+			for _, name := range component.DependsOn {
+				if !placed[name] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				orderedComponents = append(orderedComponents, component)
+				placed[component.Name] = true
+				progress = true
+				break
+			}
+		}
+
+		if !progress {
+			return nil, fmt.Errorf("the component dependencies contain a cycle")
+		}
+	}
+
+	return orderedComponents, nil
+}
+
 func (p *Packager) isCompatibleComponent(component types.ZarfComponent, filterByOS bool) bool {
 	message.Debugf("config.isCompatibleComponent(%s, %v)", component.Name, filterByOS)
 
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDependencies(t *testing.T) {
+	components := []types.ZarfComponent{
+		{Name: "app", DependsOn: []string{"database"}},
+		{Name: "database", DependsOn: []string{"storage"}},
+		{Name: "storage"},
+		{Name: "monitoring"},
+		{Name: "ingress-nginx", Group: "ingress"},
+		{Name: "ingress-traefik", Group: "ingress"},
+		{Name: "dashboard", DependsOn: []string{"ingress-nginx"}},
+		{Name: "missing", DependsOn: []string{"not-in-package"}},
+		{Name: "cycle-a", DependsOn: []string{"cycle-b"}},
+		{Name: "cycle-b", DependsOn: []string{"cycle-a"}},
+	}
+
+	byName := make(map[string]types.ZarfComponent)
+	for _, component := range components {
+		byName[component.Name] = component
+	}
+
+	tests := []struct {
+		name     string
+		selected []string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "no dependencies",
+			selected: []string{"monitoring", "storage"},
+			expected: []string{"storage", "monitoring"},
+		},
+		{
+			name:     "transitive dependencies are included",
+			selected: []string{"app"},
+			expected: []string{"storage", "database", "app"},
+		},
+		{
+			name:     "dependencies come before the components that need them",
+			selected: []string{"monitoring", "app", "storage"},
+			expected: []string{"storage", "database", "app", "monitoring"},
+		},
+		{
+			name:     "group member is included as a dependency",
+			selected: []string{"dashboard"},
+			expected: []string{"ingress-nginx", "dashboard"},
+		},
+		{
+			name:     "chosen group member satisfies the dependency",
+			selected: []string{"ingress-nginx", "dashboard"},
+			expected: []string{"ingress-nginx", "dashboard"},
+		},
+		{
+			name:     "dependency conflicts with the chosen group member",
+			selected: []string{"ingress-traefik", "dashboard"},
+			err:      "component dashboard depends on component ingress-nginx but ingress-traefik was chosen from the group ingress",
+		},
+		{
+			name:     "dependency not in the package",
+			selected: []string{"missing"},
+			err:      "component missing depends on component not-in-package which is not in this package",
+		},
+		{
+			name:     "dependency cycle",
+			selected: []string{"cycle-a"},
+			err:      "the component dependencies contain a cycle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var selected []types.ZarfComponent
+			for _, name := range tt.selected {
+				selected = append(selected, byName[name])
+			}
+
+			resolved, err := resolveDependencies(components, selected)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, component := range resolved {
+				names = append(names, component.Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
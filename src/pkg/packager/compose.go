@@ -204,6 +204,14 @@ func (p *Packager) mergeComponentOverrides(target *types.ZarfComponent, override
 		target.Scripts.TimeoutSeconds = override.Scripts.TimeoutSeconds
 	}
 
+	// Merge waits.
+	target.Wait = append(target.Wait, override.Wait...)
+
+	// Merge actions.
+	target.Actions.OnCreate = mergeActionSet(target.Actions.OnCreate, override.Actions.OnCreate)
+	target.Actions.OnDeploy = mergeActionSet(target.Actions.OnDeploy, override.Actions.OnDeploy)
+	target.Actions.OnRemove = mergeActionSet(target.Actions.OnRemove, override.Actions.OnRemove)
+
 	// Merge Only filters
 	target.Only.Cluster.Distros = append(target.Only.Cluster.Distros, override.Only.Cluster.Distros...)
 	if override.Only.Cluster.Architecture != "" {
@@ -248,3 +256,12 @@ func (p *Packager) getComposedFilePath(originalPath string, pathPrefix string) s
 	// Add prefix for local files.
 	return filepath.Join(pathPrefix, originalPath)
 }
+
+// mergeActionSet appends the actions of the importing component to those of the imported component.
+func mergeActionSet(target, override types.ZarfComponentActionSet) types.ZarfComponentActionSet {
+	target.Before = append(target.Before, override.Before...)
+	target.After = append(target.After, override.After...)
+	target.OnFailure = append(target.OnFailure, override.OnFailure...)
+
+	return target
+}
@@ -61,6 +61,13 @@ func (p *Packager) Create(baseDir string) error {
 		return fmt.Errorf("unable to fill variables in template: %s", err.Error())
 	}
 
+	// Leave out images and repos that already exist in the differential base package
+	if p.cfg.CreateOpts.Differential != "" {
+		if err := p.removeDifferentialCopies(); err != nil {
+			return fmt.Errorf("unable to create differential package: %w", err)
+		}
+	}
+
 	// Save the transformed config
 	if err := p.writeYaml(); err != nil {
 		return fmt.Errorf("unable to write zarf.yaml: %w", err)
@@ -78,7 +85,7 @@ func (p *Packager) Create(baseDir string) error {
 	if p.cfg.IsInitConfig {
 		// Load seed images into their own happy little tarball for ease of import on init
 		seedImage := fmt.Sprintf("%s:%s", config.ZarfSeedImage, config.ZarfSeedTag)
-		pulledImages, err := p.pullImages([]string{seedImage}, p.tmp.SeedImage)
+		pulledImages, err := p.pullImages([]string{seedImage}, "", p.tmp.SeedImage, nil)
 		if err != nil {
 			return fmt.Errorf("unable to pull the seed image after 3 attempts: %w", err)
 		}
@@ -99,6 +106,7 @@ func (p *Packager) Create(baseDir string) error {
 	for _, component := range p.cfg.Pkg.Components {
 		componentSBOM, err := p.addComponent(component)
 		if err != nil {
+			p.runFailureActions(component.Actions.OnCreate.OnFailure)
 			return fmt.Errorf("unable to add component: %w", err)
 		}
 
@@ -115,17 +123,32 @@ func (p *Packager) Create(baseDir string) error {
 	if len(combinedImageList) > 0 {
 		uniqueList := utils.Unique(combinedImageList)
 
+		// Pull the images pinned in the zarf.lock by digest so a moved tag does not change the package
+		var pinned map[string]string
+		if !p.cfg.CreateOpts.UpdateLock {
+			lock, err := readLock()
+			if err != nil {
+				return err
+			}
+			pinned = p.lockedImageDigests(lock)
+		}
+
 		var err error
-		if pulledImages, err = p.pullImages(uniqueList, p.tmp.Images); err != nil {
+		if pulledImages, err = p.pullImages(uniqueList, p.tmp.Images, "", pinned); err != nil {
 			return fmt.Errorf("unable to pull images after 3 attempts: %w", err)
 		}
+
+		// Pin the images to the digests they were pulled at
+		if err := p.pinImageDigests(); err != nil {
+			return err
+		}
 	}
 
 	// Ignore SBOM creation if there the flag is set
 	if p.cfg.CreateOpts.SkipSBOM {
 		message.Debug("Skipping image SBOM processing per --skip-sbom flag")
 	} else {
-		sbom.Catalog(componentSBOMs, pulledImages, p.tmp.Images, p.tmp.Sboms)
+		sbom.Catalog(componentSBOMs, pulledImages, p.tmp.Sboms)
 	}
 
 	// In case the directory was changed, reset to prevent breaking relative target paths
@@ -133,6 +156,19 @@ func (p *Packager) Create(baseDir string) error {
 		_ = os.Chdir(originalDir)
 	}
 
+	// Record the checksum of every file so that the zarf.yaml (and its signature) covers the whole package
+	if err := p.generateChecksums(); err != nil {
+		return fmt.Errorf("unable to generate the package checksums: %w", err)
+	}
+
+	// Sign the zarf.yaml if a signing key was given
+	if p.cfg.CreateOpts.SigningKeyPath != "" {
+		signaturePath := filepath.Join(p.tmp.Base, config.ZarfYAMLSignature)
+		if err := utils.CosignSignBlob(p.tmp.ZarfYaml, signaturePath, p.cfg.CreateOpts.SigningKeyPath, p.cfg.CreateOpts.SigningKeyPassword); err != nil {
+			return fmt.Errorf("unable to sign the package: %w", err)
+		}
+	}
+
 	// Use the output path if the user specified it.
 	packageName := filepath.Join(p.cfg.CreateOpts.OutputDirectory, p.GetPackageName())
 
@@ -202,16 +238,20 @@ func (p *Packager) Create(baseDir string) error {
 	return nil
 }
 
-func (p *Packager) pullImages(imgList []string, path string) (map[name.Tag]v1.Image, error) {
+// pullImages pulls the images into an OCI image layout, or into a docker tarball if no layout path is given (for the seed image).
+func (p *Packager) pullImages(imgList []string, imagesPath, tarballPath string, pinned map[string]string) (map[name.Tag]v1.Image, error) {
 	var pulledImages map[name.Tag]v1.Image
 	var err error
 
 	return pulledImages, utils.Retry(func() error {
 		imgConfig := images.ImgConfig{
-			TarballPath:   path,
+			ImagesPath:    imagesPath,
+			TarballPath:   tarballPath,
 			ImgList:       imgList,
+			Pinned:        pinned,
 			Insecure:      p.cfg.CreateOpts.Insecure,
 			NoLocalImages: p.cfg.CreateOpts.NoLocalImages,
+			Concurrency:   p.cfg.CreateOpts.Concurrency,
 		}
 
 		pulledImages, err = imgConfig.PullAll()
@@ -223,6 +263,10 @@ func (p *Packager) pullImages(imgList []string, path string) (map[name.Tag]v1.Im
 func (p *Packager) addComponent(component types.ZarfComponent) (*types.ComponentSBOM, error) {
 	message.HeaderInfof("📦 %s COMPONENT", strings.ToUpper(component.Name))
 
+	if err := p.runActions(component.Actions.OnCreate.Before); err != nil {
+		return nil, fmt.Errorf("unable to run the onCreate before actions: %w", err)
+	}
+
 	// Create the component directory.
 	componentPath, err := p.createComponentPaths(component)
 	if err != nil {
@@ -313,7 +357,11 @@ func (p *Packager) addComponent(component types.ZarfComponent) (*types.Component
 		defer spinner.Success()
 
 		for _, data := range component.DataInjections {
-			spinner.Updatef("Copying data injection %s for %s", data.Target.Path, data.Target.Selector)
+			target := data.Target.Selector
+			if data.Target.PVC != "" {
+				target = data.Target.PVC
+			}
+			spinner.Updatef("Copying data injection %s for %s", data.Target.Path, target)
 			destination := filepath.Join(componentPath.DataInjections, filepath.Base(data.Target.Path))
 			if err := utils.CreatePathAndCopy(data.Source, destination); err != nil {
 				return nil, fmt.Errorf("unable to copy data injection %s: %w", data.Source, err)
@@ -378,5 +426,9 @@ func (p *Packager) addComponent(component types.ZarfComponent) (*types.Component
 		}
 	}
 
+	if err := p.runActions(component.Actions.OnCreate.After); err != nil {
+		return nil, fmt.Errorf("unable to run the onCreate after actions: %w", err)
+	}
+
 	return &componentSBOM, nil
 }
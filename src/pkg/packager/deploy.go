@@ -19,6 +19,7 @@ import (
 	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
 	"github.com/defenseunicorns/zarf/src/internal/packager/images"
 	"github.com/defenseunicorns/zarf/src/internal/packager/template"
+	"github.com/defenseunicorns/zarf/src/internal/packager/wait"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/types"
@@ -27,6 +28,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// legacyImagesTarball is where packages created by older versions of Zarf store their images.
+const legacyImagesTarball = "images.tar"
+
 var valueTemplate template.Values
 var connectStrings = make(types.ConnectStrings)
 
@@ -43,6 +47,16 @@ func (p *Packager) Deploy() error {
 		p.cfg.IsInitConfig = true
 	}
 
+	// Make sure every values override targets a chart in the package before changing anything
+	if err := p.validateValuesOverrides(); err != nil {
+		return err
+	}
+
+	// A dry run only reports what would change, so it skips the preflight checks and confirmation
+	if p.cfg.DeployOpts.DryRun {
+		return p.planDeployment()
+	}
+
 	// If init config, make sure things are ready
 	if p.cfg.IsInitConfig {
 		utils.RunPreflightChecks()
@@ -53,6 +67,19 @@ func (p *Packager) Deploy() error {
 		return fmt.Errorf("deployment cancelled")
 	}
 
+	// Differential packages rely on their base package already being in the cluster
+	if err := p.checkDifferentialBase(); err != nil {
+		return err
+	}
+
+	// Connect to the cluster before the variables are set so the values saved by a previous deployment can be reused
+	if p.cluster == nil && !p.cfg.IsInitConfig && len(p.cfg.Pkg.Variables) > 0 && p.requiresCluster() {
+		var err error
+		if p.cluster, err = cluster.NewClusterWithWait(30 * time.Second); err != nil {
+			return fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		}
+	}
+
 	// Set variables and prompt if --confirm is not set
 	if err := p.setActiveVariables(); err != nil {
 		return fmt.Errorf("unable to set the active variables: %w", err)
@@ -71,7 +98,49 @@ func (p *Packager) Deploy() error {
 	// Save deployed package information to k8s
 	// Note: Not all packages need k8s; check if k8s is being used before saving the secret
 	if p.cluster != nil {
-		p.cluster.RecordPackageDeployment(p.cfg.Pkg, deployedComponents)
+		variables := p.packageVariableValues()
+
+		if err := p.cluster.RecordPackageDeployment(p.cfg.Pkg, deployedComponents, variables); err != nil {
+			return fmt.Errorf("unable to record the deployment of this package: %w", err)
+		}
+
+		if err := p.cluster.RecordPackageVariables(p.cfg.Pkg.Metadata.Name, variables); err != nil {
+			message.Warnf("Unable to save the variable values of this package for the next deployment: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// requiresCluster returns true if any component of the package deploys something to the cluster.
+func (p *Packager) requiresCluster() bool {
+	for _, component := range p.cfg.Pkg.Components {
+		if len(component.Images) > 0 || len(component.Charts) > 0 || len(component.Manifests) > 0 ||
+			len(component.Repos) > 0 || len(component.DataInjections) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateValuesOverrides checks that every --values override targets a chart in the package and that its file exists.
+func (p *Packager) validateValuesOverrides() error {
+	for key, path := range p.cfg.DeployOpts.ValuesOverrides {
+		found := false
+		for _, component := range p.cfg.Pkg.Components {
+			for _, chart := range component.Charts {
+				if strings.EqualFold(key, component.Name+"/"+chart.Name) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("unable to override the values of %s, there is no chart with that <component>/<chart> name in the package", key)
+		}
+
+		if utils.InvalidPath(path) {
+			return fmt.Errorf("unable to find the values file %s for %s", path, key)
+		}
 	}
 
 	return nil
@@ -88,32 +157,104 @@ func (p *Packager) deployComponents() (deployedComponents []types.DeployedCompon
 		return deployedComponents, fmt.Errorf("unable to generate the value template: %w", err)
 	}
 
+	// The zarf.yaml includes the build metadata, so its checksum identifies this exact package build
+	checksum, err := utils.GetSha256Sum(filepath.Join(p.tmp.Base, config.ZarfYAML))
+	if err != nil {
+		return deployedComponents, fmt.Errorf("unable to compute the package checksum: %w", err)
+	}
+
+	// Find the components already deployed by an earlier attempt to deploy this package
+	finishedComponents, err := p.loadDeployProgress(checksum)
+	if err != nil {
+		return deployedComponents, err
+	}
+
 	for _, component := range componentsToDeploy {
-		var charts []types.InstalledChart
+		if finished, ok := finishedComponents[component.Name]; ok {
+			message.Notef("Skipping the component (%s) since it was deployed by an earlier attempt", component.Name)
 
-		deployedComponent := types.DeployedComponent{Name: component.Name}
+			// The variables set by the component's actions are not saved, so set them again for the components that follow
+			if err := p.runSetVariableActions(component.Actions.OnDeploy); err != nil {
+				return deployedComponents, fmt.Errorf("unable to set the variables of the skipped component %s: %w", component.Name, err)
+			}
+
+			deployedComponents = append(deployedComponents, finished)
+			config.SetDeployingComponents(deployedComponents)
+			continue
+		}
+
+		var deployedComponent types.DeployedComponent
 
 		if p.cfg.IsInitConfig {
-			charts, err = p.deployInitComponent(component)
+			deployedComponent, err = p.deployInitComponent(component)
 		} else {
-			charts, err = p.deployComponent(component, false /* keep img checksum */)
+			deployedComponent, err = p.deployComponent(component, false /* keep img checksum */)
 		}
 
 		if err != nil {
 			return deployedComponents, fmt.Errorf("unable to deploy component %s: %w", component.Name, err)
 		}
 
-		// Deploy the component
-		deployedComponent.InstalledCharts = charts
+		// Record the component and everything it produced
+		deployedComponent.Name = component.Name
 		deployedComponents = append(deployedComponents, deployedComponent)
 		config.SetDeployingComponents(deployedComponents)
+
+		// Save the progress so a failure later on can be resumed with --resume
+		if p.cluster != nil && !p.cfg.IsInitConfig {
+			if err := p.cluster.RecordDeployProgress(p.cfg.Pkg, checksum, deployedComponents); err != nil {
+				message.Warnf("Unable to save the deployment progress: %s", err.Error())
+			}
+		}
 	}
 
 	config.ClearDeployingComponents()
 	return deployedComponents, nil
 }
 
-func (p *Packager) deployInitComponent(component types.ZarfComponent) (charts []types.InstalledChart, err error) {
+// loadDeployProgress returns the components finished by an earlier, incomplete deployment of the same package build when --resume is set.
+func (p *Packager) loadDeployProgress(checksum string) (map[string]types.DeployedComponent, error) {
+	finishedComponents := make(map[string]types.DeployedComponent)
+
+	if !p.cfg.DeployOpts.Resume {
+		return finishedComponents, nil
+	}
+
+	if p.cfg.IsInitConfig {
+		return finishedComponents, fmt.Errorf("resuming a deployment is not supported for init packages")
+	}
+
+	var err error
+	if p.cluster == nil {
+		p.cluster, err = cluster.NewClusterWithWait(30 * time.Second)
+		if err != nil {
+			return finishedComponents, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		}
+	}
+
+	deployedPackage, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if err != nil || deployedPackage.Progress == nil {
+		message.Note("No incomplete deployment of this package was found, deploying all components")
+		return finishedComponents, nil
+	}
+
+	if deployedPackage.Progress.Checksum != checksum {
+		message.Warn("The incomplete deployment in the cluster was for a different build of this package, deploying all components")
+		return finishedComponents, nil
+	}
+
+	for _, component := range deployedPackage.DeployedComponents {
+		for _, name := range deployedPackage.Progress.Components {
+			if component.Name == name {
+				finishedComponents[name] = component
+			}
+		}
+	}
+
+	return finishedComponents, nil
+}
+
+func (p *Packager) deployInitComponent(component types.ZarfComponent) (deployedComponent types.DeployedComponent, err error) {
 	hasExternalRegistry := p.cfg.InitOpts.RegistryInfo.Address != ""
 	isSeedRegistry := component.Name == "zarf-seed-registry"
 	isRegistry := component.Name == "zarf-registry"
@@ -124,14 +265,14 @@ func (p *Packager) deployInitComponent(component types.ZarfComponent) (charts []
 	if isSeedRegistry {
 		p.cluster, err = cluster.NewClusterWithWait(5 * time.Minute)
 		if err != nil {
-			return charts, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+			return deployedComponent, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
 		}
 		p.cluster.InitZarfState(p.tmp, p.cfg.InitOpts)
 	}
 
 	if hasExternalRegistry && (isSeedRegistry || isInjector || isRegistry) {
 		message.Notef("Not deploying the component (%s) since external registry information was provided during `zarf init`", component.Name)
-		return charts, nil
+		return deployedComponent, nil
 	}
 
 	// Before deploying the seed registry, start the injector
@@ -139,16 +280,16 @@ func (p *Packager) deployInitComponent(component types.ZarfComponent) (charts []
 		p.cluster.RunInjectionMadness(p.tmp)
 	}
 
-	charts, err = p.deployComponent(component, isAgent /* skip img checksum if isAgent */)
+	deployedComponent, err = p.deployComponent(component, isAgent /* skip img checksum if isAgent */)
 	if err != nil {
-		return charts, fmt.Errorf("unable to deploy component %s: %w", component.Name, err)
+		return deployedComponent, fmt.Errorf("unable to deploy component %s: %w", component.Name, err)
 	}
 
 	// Do cleanup for when we inject the seed registry during initialization
 	if isSeedRegistry {
 		err := p.cluster.PostSeedRegistry(p.tmp)
 		if err != nil {
-			return charts, fmt.Errorf("unable to seed the Zarf Registry: %w", err)
+			return deployedComponent, fmt.Errorf("unable to seed the Zarf Registry: %w", err)
 		}
 
 		seedImage := fmt.Sprintf("%s:%s", config.ZarfSeedImage, config.ZarfSeedTag)
@@ -161,21 +302,21 @@ func (p *Packager) deployInitComponent(component types.ZarfComponent) (charts []
 
 		// Push the seed images into to Zarf registry
 		if err = imgConfig.PushToZarfRegistry(); err != nil {
-			return charts, fmt.Errorf("unable to push the seed images to the Zarf Registry: %w", err)
+			return deployedComponent, fmt.Errorf("unable to push the seed images to the Zarf Registry: %w", err)
 		}
 	}
 
-	return charts, nil
+	return deployedComponent, nil
 }
 
 // Deploy a Zarf Component.
-func (p *Packager) deployComponent(component types.ZarfComponent, noImgChecksum bool) (charts []types.InstalledChart, err error) {
+func (p *Packager) deployComponent(component types.ZarfComponent, noImgChecksum bool) (deployedComponent types.DeployedComponent, err error) {
 	message.Debugf("packager.deployComponent(%#v, %#v", p.tmp, component)
 
 	// Toggles for general deploy operations
 	componentPath, err := p.createComponentPaths(component)
 	if err != nil {
-		return charts, fmt.Errorf("unable to create the component paths: %w", err)
+		return deployedComponent, fmt.Errorf("unable to create the component paths: %w", err)
 	}
 
 	// All components now require a name
@@ -187,66 +328,129 @@ func (p *Packager) deployComponent(component types.ZarfComponent, noImgChecksum
 	hasRepos := len(component.Repos) > 0
 	hasDataInjections := len(component.DataInjections) > 0
 
+	// Run the onFailure actions if anything below fails
+	onDeploy := component.Actions.OnDeploy
+	defer func() {
+		if err != nil {
+			p.runFailureActions(onDeploy.OnFailure)
+		}
+	}()
+
+	if err = p.runActions(onDeploy.Before); err != nil {
+		return deployedComponent, fmt.Errorf("unable to run the onDeploy before actions: %w", err)
+	}
+
 	// Run the 'before' scripts and move files before we do anything else
 	if err = p.runComponentScripts(component.Scripts.Before, component.Scripts); err != nil {
-		return charts, fmt.Errorf("unable to run the 'before' scripts: %w", err)
+		return deployedComponent, fmt.Errorf("unable to run the 'before' scripts: %w", err)
 	}
 
-	if err := p.processComponentFiles(component, componentPath.Files); err != nil {
-		return charts, fmt.Errorf("unable to process the component files: %w", err)
+	if deployedComponent.Files, deployedComponent.Symlinks, err = p.processComponentFiles(component, componentPath.Files); err != nil {
+		return deployedComponent, fmt.Errorf("unable to process the component files: %w", err)
 	}
 
-	if !valueTemplate.Ready() && (hasImages || hasCharts || hasManifests || hasRepos) {
+	if !valueTemplate.Ready() && (hasImages || hasCharts || hasManifests || hasRepos || hasDataInjections) {
 
 		// Make sure we have access to the cluster
 		if p.cluster == nil {
 			p.cluster, err = cluster.NewClusterWithWait(30 * time.Second)
 			if err != nil {
-				return charts, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+				return deployedComponent, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
 			}
 		}
 
 		valueTemplate, err = p.getUpdatedValueTemplate(component)
 		if err != nil {
-			return charts, fmt.Errorf("unable to get the updated value template: %w", err)
+			return deployedComponent, fmt.Errorf("unable to get the updated value template: %w", err)
+		}
+	}
+
+	// Injections into PersistentVolumeClaims run a helper pod from the image zarf init seeded into the internal registry
+	if hasDataInjections && !p.cfg.State.RegistryInfo.InternalRegistry {
+		for _, data := range component.DataInjections {
+			if data.Target.PVC != "" {
+				return deployedComponent, fmt.Errorf("unable to inject data into the PersistentVolumeClaim %s: this requires the internal Zarf registry, but the cluster was initialized with the external registry %s", data.Target.PVC, p.cfg.State.RegistryInfo.Address)
+			}
 		}
 	}
 
 	if hasImages {
 		if err := p.pushImagesToRegistry(component.Images, noImgChecksum); err != nil {
-			return charts, fmt.Errorf("unable to push images to the registry: %w", err)
+			return deployedComponent, fmt.Errorf("unable to push images to the registry: %w", err)
 		}
+		deployedComponent.Images = component.Images
+	}
+
+	// Differential packages leave out the images their base package already deployed for this component
+	if baseImages := p.differentialBaseImages[component.Name]; len(baseImages) > 0 {
+		deployedComponent.Images = append(append([]string{}, deployedComponent.Images...), baseImages...)
 	}
 
 	if hasRepos {
 		if err = p.pushReposToRepository(componentPath.Repos, component.Repos); err != nil {
-			return charts, fmt.Errorf("unable to push the repos to the repository: %w", err)
+			return deployedComponent, fmt.Errorf("unable to push the repos to the repository: %w", err)
 		}
+		deployedComponent.Repos = component.Repos
 	}
 
 	if hasDataInjections {
+		// Injections into PersistentVolumeClaims finish before the charts that use the claims are installed
+		var podInjections []types.ZarfDataInjection
+		for _, data := range component.DataInjections {
+			if data.Target.PVC == "" {
+				podInjections = append(podInjections, data)
+				continue
+			}
+			if err = p.cluster.HandleDataInjection(data, componentPath); err != nil {
+				return deployedComponent, fmt.Errorf("unable to inject data into the PersistentVolumeClaim %s: %w", data.Target.PVC, err)
+			}
+		}
+
 		waitGroup := sync.WaitGroup{}
-		defer waitGroup.Wait()
-		p.performDataInjections(&waitGroup, componentPath, component.DataInjections)
+		injectionErrs := p.performDataInjections(&waitGroup, componentPath, podInjections)
+
+		// Fail the component if any data injection fails once the rest of the component has deployed
+		defer func() {
+			waitGroup.Wait()
+			close(injectionErrs)
+			for injectionErr := range injectionErrs {
+				if err == nil {
+					err = fmt.Errorf("unable to inject data: %w", injectionErr)
+				} else {
+					message.Errorf(injectionErr, "Unable to inject data")
+				}
+			}
+		}()
 	}
 
 	if hasCharts || hasManifests {
-		if charts, err = p.installChartAndManifests(componentPath, component); err != nil {
-			return charts, fmt.Errorf("unable to install helm chart(s): %w", err)
+		if deployedComponent.InstalledCharts, err = p.installChartAndManifests(componentPath, component); err != nil {
+			return deployedComponent, fmt.Errorf("unable to install helm chart(s): %w", err)
 		}
 	}
 
 	// Run the 'after' scripts after all other attributes of the component has been deployed
 	p.runComponentScripts(component.Scripts.After, component.Scripts)
 
-	return charts, nil
+	// Wait for the resources and endpoints the component needs to be ready before moving on
+	for _, condition := range component.Wait {
+		if err = wait.For(condition); err != nil {
+			return deployedComponent, fmt.Errorf("unable to complete the component waits: %w", err)
+		}
+	}
+
+	if err = p.runActions(onDeploy.After); err != nil {
+		return deployedComponent, fmt.Errorf("unable to run the onDeploy after actions: %w", err)
+	}
+
+	return deployedComponent, nil
 }
 
-// Move files onto the host of the machine performing the deployment.
-func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLocation string) error {
+// Move files onto the host of the machine performing the deployment, returning the files and symlinks written.
+func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLocation string) (files []string, symlinks []string, err error) {
 	// If there are no files to process, return early.
 	if len(component.Files) < 1 {
-		return nil
+		return files, symlinks, nil
 	}
 
 	spinner := *message.NewProgressSpinner("Copying %d files", len(component.Files))
@@ -260,7 +464,7 @@ func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLo
 		if file.Shasum != "" {
 			spinner.Updatef("Validating SHASUM for %s", file.Target)
 			if shasum, _ := utils.GetSha256Sum(sourceFile); shasum != file.Shasum {
-				return fmt.Errorf("shasum mismatch for file %s: expected %s, got %s", file.Source, file.Shasum, shasum)
+				return files, symlinks, fmt.Errorf("shasum mismatch for file %s: expected %s, got %s", file.Source, file.Shasum, shasum)
 			}
 		}
 
@@ -277,7 +481,7 @@ func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLo
 		if isText {
 			spinner.Updatef("Templating %s", file.Target)
 			if err := valueTemplate.Apply(component, sourceFile, true); err != nil {
-				return fmt.Errorf("unable to template file %s: %w", sourceFile, err)
+				return files, symlinks, fmt.Errorf("unable to template file %s: %w", sourceFile, err)
 			}
 		}
 
@@ -285,8 +489,9 @@ func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLo
 		spinner.Updatef("Saving %s", file.Target)
 		err = copy.Copy(sourceFile, file.Target)
 		if err != nil {
-			return fmt.Errorf("unable to copy file %s to %s: %w", sourceFile, file.Target, err)
+			return files, symlinks, fmt.Errorf("unable to copy file %s to %s: %w", sourceFile, file.Target, err)
 		}
+		files = append(files, file.Target)
 
 		// Loop over all symlinks and create them
 		for _, link := range file.Symlinks {
@@ -298,8 +503,9 @@ func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLo
 			// Create the symlink
 			err := os.Symlink(file.Target, link)
 			if err != nil {
-				return fmt.Errorf("unable to create symlink %s->%s: %w", link, file.Target, err)
+				return files, symlinks, fmt.Errorf("unable to create symlink %s->%s: %w", link, file.Target, err)
 			}
+			symlinks = append(symlinks, link)
 		}
 
 		// Cleanup now to reduce disk pressure
@@ -308,7 +514,7 @@ func (p *Packager) processComponentFiles(component types.ZarfComponent, sourceLo
 
 	spinner.Success()
 
-	return nil
+	return files, symlinks, nil
 }
 
 // Fetch the current ZarfState from the k8s cluster and generate a valueTemplate from the state values.
@@ -372,15 +578,25 @@ func (p *Packager) pushImagesToRegistry(componentImages []string, noImgChecksum
 	}
 
 	imgConfig := images.ImgConfig{
-		TarballPath: p.tmp.Images,
+		ImagesPath:  p.tmp.Images,
+		TarballPath: filepath.Join(p.tmp.Base, legacyImagesTarball),
 		ImgList:     componentImages,
 		NoChecksum:  noImgChecksum,
 		RegInfo:     p.cfg.State.RegistryInfo,
+		Concurrency: p.cfg.DeployOpts.Concurrency,
 	}
 
-	return utils.Retry(func() error {
-		return imgConfig.PushToZarfRegistry()
-	}, 3, 5*time.Second)
+	// Each image is retried on its own, so the push is not retried as a whole
+	if err := imgConfig.PushToZarfRegistry(); err != nil {
+		return err
+	}
+
+	// Record the digests the package pinned its images to so the Zarf Agent can pin pods to them
+	if err := p.cluster.RecordImageDigests(p.cfg.Pkg.Metadata.Name, componentImages, p.cfg.Pkg.Build.ImageDigests); err != nil {
+		return fmt.Errorf("unable to record the image digests: %w", err)
+	}
+
+	return nil
 }
 
 // Push all of the components git repos to the configured git server.
@@ -423,15 +639,25 @@ func (p *Packager) pushReposToRepository(reposPath string, repos []string) error
 }
 
 // Async move data into a container running in a pod on the k8s cluster.
-func (p *Packager) performDataInjections(waitGroup *sync.WaitGroup, componentPath types.ComponentPaths, dataInjections []types.ZarfDataInjection) {
+func (p *Packager) performDataInjections(waitGroup *sync.WaitGroup, componentPath types.ComponentPaths, dataInjections []types.ZarfDataInjection) chan error {
 	if len(dataInjections) > 0 {
 		message.Info("Loading data injections")
 	}
 
+	// Buffered so that every injection can report its error without blocking
+	injectionErrs := make(chan error, len(dataInjections))
+
 	for _, data := range dataInjections {
 		waitGroup.Add(1)
-		go p.cluster.HandleDataInjection(waitGroup, data, componentPath)
+		go func(data types.ZarfDataInjection) {
+			defer waitGroup.Done()
+			if err := p.cluster.HandleDataInjection(data, componentPath); err != nil {
+				injectionErrs <- err
+			}
+		}(data)
 	}
+
+	return injectionErrs
 }
 
 // Install all Helm charts and raw k8s manifests into the k8s cluster.
@@ -456,11 +682,11 @@ func (p *Packager) installChartAndManifests(componentPath types.ComponentPaths,
 			Cluster:   p.cluster,
 		}
 
-		addedConnectStrings, installedChartName, err := helmCfg.InstallOrUpgradeChart()
+		addedConnectStrings, installedChart, err := helmCfg.InstallOrUpgradeChart()
 		if err != nil {
 			return installedCharts, err
 		}
-		installedCharts = append(installedCharts, types.InstalledChart{Namespace: chart.Namespace, ChartName: installedChartName})
+		installedCharts = append(installedCharts, installedChart)
 
 		// Iterate over any connectStrings and add to the main map
 		for name, description := range addedConnectStrings {
@@ -487,11 +713,11 @@ func (p *Packager) installChartAndManifests(componentPath types.ComponentPaths,
 			Cfg:       p.cfg,
 			Cluster:   p.cluster,
 		}
-		addedConnectStrings, installedChartName, err := helmCfg.GenerateChart(manifest)
+		addedConnectStrings, installedChart, err := helmCfg.GenerateChart(manifest)
 		if err != nil {
 			return installedCharts, err
 		}
-		installedCharts = append(installedCharts, types.InstalledChart{Namespace: manifest.Namespace, ChartName: installedChartName})
+		installedCharts = append(installedCharts, installedChart)
 
 		// Iterate over any connectStrings and add to the main map
 		for name, description := range addedConnectStrings {
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/mholt/archiver/v3"
+)
+
+// loadDifferentialBase reads the zarf.yaml of the package a differential package is being built against.
+func (p *Packager) loadDifferentialBase() (pkg types.ZarfPackage, err error) {
+	message.Debugf("packager.loadDifferentialBase(%s)", p.cfg.CreateOpts.Differential)
+
+	basePath := p.cfg.CreateOpts.Differential
+	tmpDir, err := utils.MakeTempDir(config.CommonOptions.TempDirectory)
+	if err != nil {
+		return pkg, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	configPath := filepath.Join(tmpDir, config.ZarfYAML)
+
+	if IsOCIURL(basePath) {
+		if _, _, err := pullOCIZarfYaml(basePath, p.cfg.CreateOpts.Insecure, configPath); err != nil {
+			return pkg, err
+		}
+	} else {
+		if utils.InvalidPath(basePath) {
+			return pkg, fmt.Errorf("unable to find the package at %s", basePath)
+		}
+		if err := archiver.Extract(basePath, config.ZarfYAML, tmpDir); err != nil {
+			return pkg, fmt.Errorf("unable to extract the zarf.yaml from %s: %w", basePath, err)
+		}
+	}
+
+	if err := utils.ReadYaml(configPath, &pkg); err != nil {
+		return pkg, fmt.Errorf("unable to read the zarf.yaml from %s: %w", basePath, err)
+	}
+
+	return pkg, nil
+}
+
+// removeDifferentialCopies removes images and pinned git repo refs that are already present in the base package.
+func (p *Packager) removeDifferentialCopies() error {
+	base, err := p.loadDifferentialBase()
+	if err != nil {
+		return fmt.Errorf("unable to load the differential base package: %w", err)
+	}
+
+	if base.Metadata.Name != p.cfg.Pkg.Metadata.Name {
+		return fmt.Errorf("unable to build a differential package of %s against %s: package names must match", p.cfg.Pkg.Metadata.Name, base.Metadata.Name)
+	}
+
+	if base.Metadata.Version == p.cfg.Pkg.Metadata.Version {
+		return fmt.Errorf("unable to build a differential package against the same package version (%s)", base.Metadata.Version)
+	}
+
+	baseImages := map[string]bool{}
+	baseRepos := map[string]bool{}
+	for _, component := range base.Components {
+		for _, image := range component.Images {
+			baseImages[image] = true
+		}
+		for _, repo := range component.Repos {
+			baseRepos[repo] = true
+		}
+	}
+
+	var missing []string
+	for idx, component := range p.cfg.Pkg.Components {
+		var images []string
+		for _, image := range component.Images {
+			if baseImages[image] {
+				missing = append(missing, image)
+				continue
+			}
+			images = append(images, image)
+		}
+
+		var repos []string
+		for _, repo := range component.Repos {
+			// Only repos pinned to a ref are immutable, everything else is always re-pulled
+			if baseRepos[repo] && isPinnedRepo(repo) {
+				missing = append(missing, repo)
+				continue
+			}
+			repos = append(repos, repo)
+		}
+
+		p.cfg.Pkg.Components[idx].Images = images
+		p.cfg.Pkg.Components[idx].Repos = repos
+	}
+
+	missing = utils.Unique(missing)
+	message.Infof("Differential package built against %s, skipping %d images and repos already in that package", base.Metadata.Version, len(missing))
+
+	p.cfg.Pkg.Build.Differential = true
+	p.cfg.Pkg.Build.DifferentialPackageVersion = base.Metadata.Version
+	p.cfg.Pkg.Build.DifferentialMissing = missing
+
+	return nil
+}
+
+// checkDifferentialBase ensures the package a differential package was built against is deployed,
+// since its images and repos are expected to already be in the Zarf registry and git server.
+func (p *Packager) checkDifferentialBase() error {
+	if !p.cfg.Pkg.Build.Differential {
+		return nil
+	}
+
+	message.Debugf("packager.checkDifferentialBase(%s)", p.cfg.Pkg.Build.DifferentialPackageVersion)
+
+	var err error
+	if p.cluster == nil {
+		p.cluster, err = cluster.NewClusterWithWait(30 * time.Second)
+		if err != nil {
+			return fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		}
+	}
+
+	deployed, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if err != nil {
+		return fmt.Errorf("this is a differential package built against %s version %s, which must be deployed first: %w",
+			p.cfg.Pkg.Metadata.Name, p.cfg.Pkg.Build.DifferentialPackageVersion, err)
+	}
+
+	if deployed.Data.Metadata.Version != p.cfg.Pkg.Build.DifferentialPackageVersion {
+		message.Warnf("This differential package was built against version %s but version %s is deployed, images or repos may be missing",
+			p.cfg.Pkg.Build.DifferentialPackageVersion, deployed.Data.Metadata.Version)
+	}
+
+	// Keep tracking the images the base package deployed for the components of this package, so removing it cleans them up
+	missing := map[string]bool{}
+	for _, item := range p.cfg.Pkg.Build.DifferentialMissing {
+		missing[item] = true
+	}
+	p.differentialBaseImages = map[string][]string{}
+	for _, component := range deployed.DeployedComponents {
+		for _, image := range component.Images {
+			if missing[image] {
+				p.differentialBaseImages[component.Name] = append(p.differentialBaseImages[component.Name], image)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isPinnedRepo(repo string) bool {
+	// Strip the scheme so user@host style URLs are not mistaken for refs
+	if idx := strings.Index(repo, "://"); idx >= 0 {
+		repo = repo[idx+3:]
+	}
+	return strings.Contains(repo[strings.LastIndex(repo, "/")+1:], "@")
+}
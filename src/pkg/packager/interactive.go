@@ -12,6 +12,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/validate"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/types"
@@ -81,14 +82,34 @@ func (p *Packager) promptVariable(variable types.ZarfPackageVariable) (value str
 		message.Question(variable.Description)
 	}
 
-	prompt := &survey.Input{
+	// Let the user correct a value that is invalid instead of failing later
+	validator := survey.WithValidator(func(ans interface{}) error {
+		answer := fmt.Sprint(ans)
+		if variable.Sensitive && answer == "" {
+			answer = variable.Default
+		}
+		return validate.VariableValue(variable, answer)
+	})
+
+	var prompt survey.Prompt = &survey.Input{
 		Message: fmt.Sprintf("Please provide a value for \"%s\"", variable.Name),
 		Default: variable.Default,
 	}
 
-	if err = survey.AskOne(prompt, &value); err != nil {
+	// Hide sensitive values (including their default) as they are typed
+	if variable.Sensitive {
+		prompt = &survey.Password{
+			Message: fmt.Sprintf("Please provide a value for \"%s\" (leave empty to use the default)", variable.Name),
+		}
+	}
+
+	if err = survey.AskOne(prompt, &value, validator); err != nil {
 		return "", err
 	}
 
+	if variable.Sensitive && value == "" {
+		value = variable.Default
+	}
+
 	return value, nil
 }
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/images"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// readLock reads the zarf.lock next to the source zarf.yaml, returning an empty lock if there is none.
+func readLock() (lock types.ZarfLock, err error) {
+	if utils.InvalidPath(config.ZarfLock) {
+		return lock, nil
+	}
+
+	if err := utils.ReadYaml(config.ZarfLock, &lock); err != nil {
+		return lock, fmt.Errorf("unable to read the %s: %w", config.ZarfLock, err)
+	}
+	return lock, nil
+}
+
+// lockedImageDigests returns the digests the zarf.lock pins the images of the package architecture to.
+func (p *Packager) lockedImageDigests(lock types.ZarfLock) map[string]string {
+	locked := make(map[string]string)
+	for _, image := range lock.Images {
+		if image.Architecture == p.arch {
+			locked[image.Image] = image.Digest
+		}
+	}
+	return locked
+}
+
+// pinImageDigests records the digest each image was pulled at in the package zarf.yaml and in the zarf.lock next to the
+// source zarf.yaml. If the zarf.lock pins an image to a different digest the create fails unless --update-lock is set.
+// Each architecture pulls different images, so the zarf.lock keeps the digests of each architecture apart.
+func (p *Packager) pinImageDigests() error {
+	message.Debug("packager.pinImageDigests()")
+
+	digests, err := images.ImageDigests(p.tmp.Images)
+	if err != nil {
+		return fmt.Errorf("unable to read the image digests: %w", err)
+	}
+
+	lock, err := readLock()
+	if err != nil {
+		return err
+	}
+	locked := p.lockedImageDigests(lock)
+
+	var drifted []string
+	for image, digest := range digests {
+		if lockedDigest, ok := locked[image]; ok && lockedDigest != digest {
+			drifted = append(drifted, fmt.Sprintf("%s (locked %s, pulled %s)", image, lockedDigest, digest))
+		}
+	}
+	sort.Strings(drifted)
+
+	if len(drifted) > 0 {
+		if !p.cfg.CreateOpts.UpdateLock {
+			return fmt.Errorf("%d images no longer match the digests in the %s, pass --update-lock to accept the new digests: %s",
+				len(drifted), config.ZarfLock, strings.Join(drifted, ", "))
+		}
+
+		for _, image := range drifted {
+			message.Warnf("Updating the locked digest of %s", image)
+		}
+	}
+
+	// Differential packages leave out images, so keep the locked digests of the images that were not pulled
+	if p.cfg.CreateOpts.Differential == "" {
+		locked = make(map[string]string)
+	}
+	for image, digest := range digests {
+		locked[image] = digest
+	}
+
+	// Keep the digests of the other architectures as they are
+	var lockImages []types.ZarfLockImage
+	for _, image := range lock.Images {
+		if image.Architecture != p.arch {
+			lockImages = append(lockImages, image)
+		}
+	}
+	for image, digest := range locked {
+		lockImages = append(lockImages, types.ZarfLockImage{Image: image, Architecture: p.arch, Digest: digest})
+	}
+	sort.Slice(lockImages, func(i, j int) bool {
+		if lockImages[i].Image != lockImages[j].Image {
+			return lockImages[i].Image < lockImages[j].Image
+		}
+		return lockImages[i].Architecture < lockImages[j].Architecture
+	})
+	lock.Images = lockImages
+
+	if err := utils.WriteYaml(config.ZarfLock, lock, 0644); err != nil {
+		return fmt.Errorf("unable to write the %s: %w", config.ZarfLock, err)
+	}
+
+	// Rewrite the package zarf.yaml so the deployed package records the digests
+	p.cfg.Pkg.Build.ImageDigests = digests
+	_ = os.Remove(p.tmp.ZarfYaml)
+
+	return utils.WriteYaml(p.tmp.ZarfYaml, p.cfg.Pkg, 0400)
+}
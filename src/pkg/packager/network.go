@@ -51,22 +51,31 @@ func (p *Packager) handlePackagePath() error {
 	}
 	defer resp.Body.Close()
 
-	localPath := p.tmp.Base + providedURL.Path
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to download remote package: %s", resp.Status)
+	}
+
+	localPath, err := p.createDownloadPath(filepath.Base(providedURL.Path))
+	if err != nil {
+		return err
+	}
+
 	message.Debugf("Creating local package with the path: %s", localPath)
-	packageFile, _ := os.Create(localPath)
-	_, err = io.Copy(packageFile, resp.Body)
+	packageFile, err := os.Create(localPath)
+	if err != nil {
+		return fmt.Errorf("unable to create the local package file: %w", err)
+	}
+	defer packageFile.Close()
+
+	// Calculate the shasum while the package is downloaded
+	hasher := sha256.New()
+	_, err = io.Copy(io.MultiWriter(packageFile, hasher), resp.Body)
 	if err != nil {
 		return fmt.Errorf("unable to copy the contents of the provided URL into a local file: %w", err)
 	}
 
 	// Check the shasum if necessary
 	if !opts.Insecure {
-		hasher := sha256.New()
-		_, err = io.Copy(hasher, packageFile)
-		if err != nil {
-			return fmt.Errorf("unable to calculate the sha256 of the provided remote package: %w", err)
-		}
-
 		value := hex.EncodeToString(hasher.Sum(nil))
 		if value != opts.Shasum {
 			_ = os.Remove(localPath)
@@ -74,7 +83,7 @@ func (p *Packager) handlePackagePath() error {
 		}
 	}
 
-	opts.PackagePath = localPath
+	p.cfg.DeployOpts.PackagePath = localPath
 
 	return nil
 }
@@ -85,7 +94,10 @@ func (p *Packager) handleSgetPackage() error {
 	opts := p.cfg.DeployOpts
 
 	// Create the local file for the package
-	localPath := filepath.Join(p.tmp.Base, "remote.tar.zst")
+	localPath, err := p.createDownloadPath("remote.tar.zst")
+	if err != nil {
+		return err
+	}
 	destinationFile, err := os.Create(localPath)
 	if err != nil {
 		return fmt.Errorf("unable to create the destination file: %w", err)
@@ -111,3 +123,17 @@ func (p *Packager) handleSgetPackage() error {
 
 	return nil
 }
+
+// createDownloadPath returns the path to download a remote package file to. Downloads are kept out of the temp directory
+// the package is extracted to so they are not taken for files of the package.
+func (p *Packager) createDownloadPath(fileName string) (string, error) {
+	if p.downloadDir == "" {
+		downloadDir, err := utils.MakeTempDir(config.CommonOptions.TempDirectory)
+		if err != nil {
+			return "", fmt.Errorf("unable to create the download directory: %w", err)
+		}
+		p.downloadDir = downloadDir
+	}
+
+	return filepath.Join(p.downloadDir, fileName), nil
+}
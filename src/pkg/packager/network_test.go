@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/mholt/archiver/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRemotePackage(t *testing.T) {
+	pkg := types.ZarfPackage{
+		Kind:       "ZarfPackageConfig",
+		Metadata:   types.ZarfMetadata{Name: "remote", Version: "0.0.1"},
+		Components: []types.ZarfComponent{{Name: "files", Required: true}},
+	}
+
+	// Build a package archive with checksums to serve
+	src, _ := newTestOCIPackage(t, pkg)
+	require.NoError(t, src.generateChecksums())
+
+	serveDir := t.TempDir()
+	archivePath := filepath.Join(serveDir, "zarf-package-remote-amd64.tar.zst")
+	require.NoError(t, archiver.Archive([]string{src.tmp.Base + string(os.PathSeparator)}, archivePath))
+	shasum, err := utils.GetSha256Sum(archivePath)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.FileServer(http.Dir(serveDir)))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		shasum   string
+		insecure bool
+		err      string
+	}{
+		{
+			name:   "matching shasum",
+			path:   "/zarf-package-remote-amd64.tar.zst",
+			shasum: shasum,
+		},
+		{
+			name:     "insecure without a shasum",
+			path:     "/zarf-package-remote-amd64.tar.zst",
+			insecure: true,
+		},
+		{
+			name:   "shasum mismatch",
+			path:   "/zarf-package-remote-amd64.tar.zst",
+			shasum: "0000",
+			err:    "shasum of remote package does not match provided shasum",
+		},
+		{
+			name: "no shasum",
+			path: "/zarf-package-remote-amd64.tar.zst",
+			err:  "remote package provided without a shasum",
+		},
+		{
+			name:   "not found",
+			path:   "/zarf-package-missing-amd64.tar.zst",
+			shasum: shasum,
+			err:    "404 Not Found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(&types.PackagerConfig{DeployOpts: types.ZarfDeployOptions{
+				PackagePath: server.URL + tt.path,
+				Shasum:      tt.shasum,
+				Insecure:    tt.insecure,
+			}})
+			require.NoError(t, err)
+			defer p.ClearTempPaths()
+
+			err = p.loadZarfPkg()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The download is kept out of the extracted package, so the checksums validate
+			require.Equal(t, pkg.Metadata, p.cfg.Pkg.Metadata)
+			require.Equal(t, listTempFiles(t, src.tmp.Base), listTempFiles(t, p.tmp.Base))
+
+			// The download is removed with the temp paths
+			p.ClearTempPaths()
+			require.True(t, utils.InvalidPath(p.cfg.DeployOpts.PackagePath))
+		})
+	}
+}
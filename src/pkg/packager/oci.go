@@ -0,0 +1,457 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/images"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ocitypes "github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/mholt/archiver/v3"
+)
+
+const (
+	// OCIURLPrefix is the scheme used to reference a Zarf package stored in an OCI registry.
+	OCIURLPrefix = "oci://"
+
+	// ZarfConfigMediaType is the media type of the zarf.yaml config blob of a published package.
+	ZarfConfigMediaType ocitypes.MediaType = "application/vnd.zarf.config.v1+yaml"
+	// ZarfLayerMediaType is the media type of each file layer of a published package.
+	ZarfLayerMediaType ocitypes.MediaType = "application/vnd.zarf.layer.v1.blob"
+
+	ociTitleAnnotation       = "org.opencontainers.image.title"
+	ociDescriptionAnnotation = "org.opencontainers.image.description"
+	ociVersionAnnotation     = "org.opencontainers.image.version"
+
+	ociComponentsDir = "components"
+)
+
+// IsOCIURL returns true if the given path references a package in an OCI registry.
+func IsOCIURL(path string) bool {
+	return strings.HasPrefix(path, OCIURLPrefix)
+}
+
+// ociFileLayer is a v1.Layer backed by a file on disk so large blobs are streamed rather than held in memory.
+type ociFileLayer struct {
+	path      string
+	digest    v1.Hash
+	size      int64
+	mediaType ocitypes.MediaType
+}
+
+func newOCIFileLayer(path string, mediaType ocitypes.MediaType) (*ociFileLayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	digest, size, err := v1.SHA256(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute the digest of %s: %w", path, err)
+	}
+
+	return &ociFileLayer{path: path, digest: digest, size: size, mediaType: mediaType}, nil
+}
+
+func (l *ociFileLayer) Digest() (v1.Hash, error)               { return l.digest, nil }
+func (l *ociFileLayer) DiffID() (v1.Hash, error)               { return l.digest, nil }
+func (l *ociFileLayer) Compressed() (io.ReadCloser, error)     { return os.Open(l.path) }
+func (l *ociFileLayer) Uncompressed() (io.ReadCloser, error)   { return os.Open(l.path) }
+func (l *ociFileLayer) Size() (int64, error)                   { return l.size, nil }
+func (l *ociFileLayer) MediaType() (ocitypes.MediaType, error) { return l.mediaType, nil }
+func (l *ociFileLayer) descriptor(title string) v1.Descriptor {
+	desc := v1.Descriptor{MediaType: l.mediaType, Size: l.size, Digest: l.digest}
+	if title != "" {
+		desc.Annotations = map[string]string{ociTitleAnnotation: title}
+	}
+	return desc
+}
+
+// ociManifest wraps a raw manifest so it can be pushed with remote.Put.
+type ociManifest struct {
+	raw []byte
+}
+
+func (m ociManifest) RawManifest() ([]byte, error)           { return m.raw, nil }
+func (m ociManifest) MediaType() (ocitypes.MediaType, error) { return ocitypes.OCIManifestSchema1, nil }
+
+// parseOCIReference parses an oci:// URL into a registry reference, using defaultTag if none is provided.
+func parseOCIReference(url string, defaultTag string, insecure bool) (name.Reference, error) {
+	opts := []name.Option{}
+	if defaultTag != "" {
+		opts = append(opts, name.WithDefaultTag(defaultTag))
+	}
+	if insecure {
+		opts = append(opts, name.Insecure)
+	}
+
+	ref, err := name.ParseReference(strings.TrimPrefix(url, OCIURLPrefix), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the OCI reference %s: %w", url, err)
+	}
+
+	return ref, nil
+}
+
+func ociRemoteOptions() []remote.Option {
+	return []remote.Option{remote.WithAuthFromKeychain(authn.DefaultKeychain)}
+}
+
+// pushOCIPackage pushes the extracted package in the temp directory to the given reference.
+func (p *Packager) pushOCIPackage(ref name.Reference) error {
+	message.Debugf("packager.pushOCIPackage(%s)", ref)
+
+	spinner := message.NewProgressSpinner("Publishing the package to %s", ref)
+	defer spinner.Stop()
+
+	// Archive each component directory so it can be pulled independently at deploy time
+	var componentDirs []string
+	if !utils.InvalidPath(p.tmp.Components) {
+		dirs, err := utils.ListDirectories(p.tmp.Components)
+		if err != nil {
+			return err
+		}
+		componentDirs = dirs
+	}
+	for _, dir := range componentDirs {
+		spinner.Updatef("Archiving component %s", filepath.Base(dir))
+		if err := archiver.Archive([]string{dir}, dir+".tar"); err != nil {
+			return fmt.Errorf("unable to archive the component %s: %w", filepath.Base(dir), err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+
+	files, err := utils.RecursiveFileList(p.tmp.Base, nil)
+	if err != nil {
+		return fmt.Errorf("unable to list the package contents: %w", err)
+	}
+
+	configLayer, err := newOCIFileLayer(p.tmp.ZarfYaml, ZarfConfigMediaType)
+	if err != nil {
+		return err
+	}
+
+	repo := ref.Context()
+	opts := ociRemoteOptions()
+
+	manifest := v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     ocitypes.OCIManifestSchema1,
+		Config:        configLayer.descriptor(""),
+		Annotations: map[string]string{
+			ociTitleAnnotation:       p.cfg.Pkg.Metadata.Name,
+			ociDescriptionAnnotation: p.cfg.Pkg.Metadata.Description,
+			ociVersionAnnotation:     p.cfg.Pkg.Metadata.Version,
+		},
+	}
+
+	layers := []*ociFileLayer{configLayer}
+
+	for _, file := range files {
+		rel, err := filepath.Rel(p.tmp.Base, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		layer, err := newOCIFileLayer(file, ZarfLayerMediaType)
+		if err != nil {
+			return err
+		}
+
+		layers = append(layers, layer)
+		manifest.Layers = append(manifest.Layers, layer.descriptor(rel))
+	}
+
+	// Existing blobs are detected by the registry client and skipped
+	for idx, layer := range layers {
+		spinner.Updatef("Pushing layer %d of %d (%s)", idx+1, len(layers), utils.ByteFormat(float64(layer.size), 2))
+		err := utils.Retry(func() error {
+			return remote.WriteLayer(repo, layer, opts...)
+		}, 3, 5*time.Second)
+		if err != nil {
+			return fmt.Errorf("unable to push layer %s: %w", layer.digest, err)
+		}
+	}
+
+	raw, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("unable to generate the package manifest: %w", err)
+	}
+
+	spinner.Updatef("Pushing the package manifest")
+	if err := remote.Put(ref, ociManifest{raw: raw}, opts...); err != nil {
+		return fmt.Errorf("unable to push the package manifest: %w", err)
+	}
+
+	spinner.Successf("Published %s", ref)
+	return nil
+}
+
+// handleOCIPackage pulls the zarf.yaml and only the layers needed by the selected components into the temp directory.
+func (p *Packager) handleOCIPackage() error {
+	message.Debugf("packager.handleOCIPackage(%s)", p.cfg.DeployOpts.PackagePath)
+
+	ref, manifest, err := pullOCIZarfYaml(p.cfg.DeployOpts.PackagePath, p.cfg.DeployOpts.Insecure, p.tmp.ZarfYaml)
+	if err != nil {
+		return err
+	}
+	opts := ociRemoteOptions()
+
+	// Verify the signature before pulling anything else
+	for _, layer := range manifest.Layers {
+		if layer.Annotations[ociTitleAnnotation] == config.ZarfYAMLSignature {
+			if err := pullOCIBlob(ref.Context(), layer, filepath.Join(p.tmp.Base, config.ZarfYAMLSignature), opts); err != nil {
+				return fmt.Errorf("unable to pull the package signature: %w", err)
+			}
+		}
+	}
+	if err := p.validatePackageSignature(p.tmp.Base); err != nil {
+		return err
+	}
+
+	var pkg types.ZarfPackage
+	if err := utils.ReadYaml(p.tmp.ZarfYaml, &pkg); err != nil {
+		return fmt.Errorf("unable to read the zarf.yaml: %w", err)
+	}
+
+	// Determine which components will be deployed so we only pull what is needed,
+	// without a --components list every component may be chosen interactively
+	requested := getRequestedComponentList(p.cfg.DeployOpts.Components)
+	var selectedComponents []types.ZarfComponent
+	for _, component := range pkg.Components {
+		if len(requested) == 0 || component.Required || isRequested(requested, component.Name) {
+			selectedComponents = append(selectedComponents, component)
+		}
+	}
+
+	// The components the selected ones depend on are deployed as well, so pull their layers too
+	selectedComponents, err = resolveDependencies(pkg.Components, selectedComponents)
+	if err != nil {
+		return fmt.Errorf("unable to resolve component dependencies: %w", err)
+	}
+
+	var selectedImages []string
+	selected := map[string]bool{}
+	for _, component := range selectedComponents {
+		selected[component.Name] = true
+		selectedImages = append(selectedImages, component.Images...)
+	}
+	needImages := len(selectedImages) > 0
+
+	// Only pull the image layout blobs used by the selected images
+	var neededBlobs map[string]bool
+	if needImages {
+		if neededBlobs, err = p.pullOCIImageManifests(ref.Context(), manifest.Layers, selectedImages, opts); err != nil {
+			return fmt.Errorf("unable to pull the image manifests: %w", err)
+		}
+	}
+
+	for _, layer := range manifest.Layers {
+		title := layer.Annotations[ociTitleAnnotation]
+		if title == "" || title == config.ZarfYAML {
+			continue
+		}
+
+		if strings.HasPrefix(title, ociComponentsDir+"/") {
+			componentName := strings.TrimSuffix(strings.TrimPrefix(title, ociComponentsDir+"/"), ".tar")
+			if !selected[componentName] {
+				message.Debugf("Skipping layer for unselected component %s", componentName)
+				continue
+			}
+		}
+
+		imagesDir := filepath.Base(p.tmp.Images)
+		if (title == legacyImagesTarball || strings.HasPrefix(title, imagesDir+"/")) && !needImages {
+			message.Debugf("Skipping the image layer %s, no selected components contain images", title)
+			continue
+		}
+
+		if strings.HasPrefix(title, imagesDir+"/blobs/") && neededBlobs != nil && !neededBlobs[path.Base(title)] {
+			message.Debugf("Skipping the image layer %s, it is not used by the selected components", title)
+			continue
+		}
+
+		destination := filepath.Join(p.tmp.Base, filepath.FromSlash(title))
+		if !strings.HasPrefix(destination, p.tmp.Base) {
+			return fmt.Errorf("invalid layer path %s", title)
+		}
+
+		// The image index and manifests were already pulled to find the needed blobs
+		if !utils.InvalidPath(destination) {
+			continue
+		}
+
+		message.Debugf("Pulling layer %s (%s)", title, layer.Digest)
+		if err := pullOCIBlob(ref.Context(), layer, destination, opts); err != nil {
+			return fmt.Errorf("unable to pull the layer %s: %w", title, err)
+		}
+
+		if strings.HasPrefix(title, ociComponentsDir+"/") {
+			if err := archiver.Unarchive(destination, p.tmp.Components); err != nil {
+				return fmt.Errorf("unable to extract the component %s: %w", title, err)
+			}
+			_ = os.Remove(destination)
+		}
+	}
+
+	return nil
+}
+
+// pullOCIImageManifests pulls the image layout index and the manifests of the given images from a published package,
+// returning the hex digests of every blob those images use. A nil map is returned for packages without an image layout.
+func (p *Packager) pullOCIImageManifests(repo name.Repository, layers []v1.Descriptor, imgList []string, opts []remote.Option) (map[string]bool, error) {
+	imagesDir := filepath.Base(p.tmp.Images)
+
+	layersByTitle := make(map[string]v1.Descriptor)
+	for _, layer := range layers {
+		layersByTitle[layer.Annotations[ociTitleAnnotation]] = layer
+	}
+
+	pullImageFile := func(title string) ([]byte, error) {
+		layer, ok := layersByTitle[title]
+		if !ok {
+			return nil, fmt.Errorf("the package does not contain %s", title)
+		}
+
+		destination := filepath.Join(p.tmp.Base, filepath.FromSlash(title))
+		if err := pullOCIBlob(repo, layer, destination, opts); err != nil {
+			return nil, err
+		}
+
+		return os.ReadFile(destination)
+	}
+
+	// Packages published by older versions of Zarf store their images in a single tarball
+	if _, ok := layersByTitle[path.Join(imagesDir, "index.json")]; !ok {
+		return nil, nil
+	}
+
+	rawIndex, err := pullImageFile(path.Join(imagesDir, "index.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := v1.ParseIndexManifest(bytes.NewReader(rawIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, img := range imgList {
+		wanted[img] = true
+	}
+
+	neededBlobs := make(map[string]bool)
+	for _, desc := range index.Manifests {
+		if !wanted[desc.Annotations[images.ImageRefAnnotation]] {
+			continue
+		}
+
+		rawManifest, err := pullImageFile(path.Join(imagesDir, "blobs", desc.Digest.Algorithm, desc.Digest.Hex))
+		if err != nil {
+			return nil, err
+		}
+
+		imgManifest, err := v1.ParseManifest(bytes.NewReader(rawManifest))
+		if err != nil {
+			return nil, err
+		}
+
+		neededBlobs[desc.Digest.Hex] = true
+		neededBlobs[imgManifest.Config.Digest.Hex] = true
+		for _, layer := range imgManifest.Layers {
+			neededBlobs[layer.Digest.Hex] = true
+		}
+	}
+
+	return neededBlobs, nil
+}
+
+// pullOCIZarfYaml fetches the manifest of a published package and writes its zarf.yaml to the destination path.
+func pullOCIZarfYaml(url string, insecure bool, destination string) (name.Reference, *v1.Manifest, error) {
+	ref, err := parseOCIReference(url, "", insecure)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := ociRemoteOptions()
+
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch the package manifest from %s: %w", ref, err)
+	}
+
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the package manifest: %w", err)
+	}
+
+	if manifest.Config.MediaType != ZarfConfigMediaType {
+		return nil, nil, fmt.Errorf("%s is not a Zarf package (config media type %s)", ref, manifest.Config.MediaType)
+	}
+
+	if err := pullOCIBlob(ref.Context(), manifest.Config, destination, opts); err != nil {
+		return nil, nil, fmt.Errorf("unable to pull the zarf.yaml: %w", err)
+	}
+
+	return ref, manifest, nil
+}
+
+// pullOCIBlob downloads a single blob from the repository into the destination path.
+func pullOCIBlob(repo name.Repository, desc v1.Descriptor, destination string, opts []remote.Option) error {
+	if err := utils.CreateDirectory(filepath.Dir(destination), 0700); err != nil {
+		return err
+	}
+
+	return utils.Retry(func() error {
+		layer, err := remote.Layer(repo.Digest(desc.Digest.String()), opts...)
+		if err != nil {
+			return err
+		}
+
+		reader, err := layer.Compressed()
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+
+		file, err := os.Create(destination)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(file, reader)
+		return err
+	}, 3, 5*time.Second)
+}
+
+func isRequested(requested []string, componentName string) bool {
+	for _, name := range requested {
+		if strings.EqualFold(strings.TrimSpace(name), componentName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/internal/packager/images"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestOCIPackage writes a package with a directory and an image for each given component into the temp paths of a new packager.
+func newTestOCIPackage(t *testing.T, pkg types.ZarfPackage) (*Packager, map[string]v1.Image) {
+	p, err := New(&types.PackagerConfig{Pkg: pkg})
+	require.NoError(t, err)
+	t.Cleanup(p.ClearTempPaths)
+
+	require.NoError(t, utils.WriteYaml(p.tmp.ZarfYaml, pkg, 0600))
+
+	imagesLayout, err := layout.Write(p.tmp.Images, empty.Index)
+	require.NoError(t, err)
+
+	componentImages := make(map[string]v1.Image)
+	for _, component := range pkg.Components {
+		dir := filepath.Join(p.tmp.Components, component.Name, "files", "0")
+		require.NoError(t, utils.CreateDirectory(dir, 0700))
+		require.NoError(t, utils.WriteFile(filepath.Join(dir, "data.txt"), []byte(component.Name)))
+
+		for _, ref := range component.Images {
+			img, err := random.Image(64, 1)
+			require.NoError(t, err)
+			require.NoError(t, imagesLayout.AppendImage(img, layout.WithAnnotations(map[string]string{images.ImageRefAnnotation: ref})))
+			componentImages[component.Name] = img
+		}
+	}
+
+	return p, componentImages
+}
+
+// imageBlobs returns the hex digests of the manifest, config and layers of an image.
+func imageBlobs(t *testing.T, img v1.Image) []string {
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	manifest, err := img.Manifest()
+	require.NoError(t, err)
+
+	blobs := []string{digest.Hex, manifest.Config.Digest.Hex}
+	for _, layer := range manifest.Layers {
+		blobs = append(blobs, layer.Digest.Hex)
+	}
+	return blobs
+}
+
+// listTempFiles returns the paths of the files in a temp directory relative to it.
+func listTempFiles(t *testing.T, base string) []string {
+	files, err := utils.RecursiveFileList(base, nil)
+	require.NoError(t, err)
+
+	var rel []string
+	for _, file := range files {
+		r, err := filepath.Rel(base, file)
+		require.NoError(t, err)
+		rel = append(rel, filepath.ToSlash(r))
+	}
+	sort.Strings(rel)
+	return rel
+}
+
+func TestOCIPackageRoundTrip(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	url := OCIURLPrefix + strings.TrimPrefix(server.URL, "http://") + "/test/roundtrip:0.0.1"
+
+	pkg := types.ZarfPackage{
+		Kind:     "ZarfPackageConfig",
+		Metadata: types.ZarfMetadata{Name: "roundtrip", Version: "0.0.1"},
+		Components: []types.ZarfComponent{
+			{Name: "base", Required: true, Images: []string{"example.com/base:1.0.0"}},
+			{Name: "app", Images: []string{"example.com/app:1.0.0"}},
+		},
+	}
+	src, _ := newTestOCIPackage(t, pkg)
+	expected := listTempFiles(t, src.tmp.Base)
+
+	ref, err := parseOCIReference(url, "", true)
+	require.NoError(t, err)
+	require.NoError(t, src.pushOCIPackage(ref))
+
+	dst, err := New(&types.PackagerConfig{DeployOpts: types.ZarfDeployOptions{PackagePath: url, Insecure: true, Components: "app"}})
+	require.NoError(t, err)
+	defer dst.ClearTempPaths()
+	require.NoError(t, dst.handleOCIPackage())
+
+	// Every file of the package comes back to the same place with the same content
+	require.Equal(t, expected, listTempFiles(t, dst.tmp.Base))
+	for _, file := range expected {
+		want, err := os.ReadFile(filepath.Join(src.tmp.Base, filepath.FromSlash(file)))
+		if err != nil {
+			// Component directories are archived when they are published
+			continue
+		}
+		got, err := os.ReadFile(filepath.Join(dst.tmp.Base, filepath.FromSlash(file)))
+		require.NoError(t, err)
+		require.Equal(t, want, got, file)
+	}
+
+	var pulled types.ZarfPackage
+	require.NoError(t, utils.ReadYaml(dst.tmp.ZarfYaml, &pulled))
+	require.Equal(t, pkg.Metadata, pulled.Metadata)
+}
+
+func TestOCIPackagePartialPull(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	url := OCIURLPrefix + strings.TrimPrefix(server.URL, "http://") + "/test/partial:0.0.1"
+
+	pkg := types.ZarfPackage{
+		Kind:     "ZarfPackageConfig",
+		Metadata: types.ZarfMetadata{Name: "partial", Version: "0.0.1"},
+		Components: []types.ZarfComponent{
+			{Name: "required", Required: true, Images: []string{"example.com/required:1.0.0"}},
+			{Name: "dependency", Images: []string{"example.com/dependency:1.0.0"}},
+			{Name: "app", DependsOn: []string{"dependency"}, Images: []string{"example.com/app:1.0.0"}},
+			{Name: "unselected", Images: []string{"example.com/unselected:1.0.0"}},
+			{Name: "no-images"},
+		},
+	}
+	src, componentImages := newTestOCIPackage(t, pkg)
+	ref, err := parseOCIReference(url, "", true)
+	require.NoError(t, err)
+	require.NoError(t, src.pushOCIPackage(ref))
+
+	tests := []struct {
+		name       string
+		components string
+		expected   []string
+	}{
+		{name: "required only", components: "no-images", expected: []string{"required", "no-images"}},
+		{name: "with dependencies", components: "app", expected: []string{"required", "dependency", "app"}},
+		{name: "all", components: "", expected: []string{"required", "dependency", "app", "unselected", "no-images"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst, err := New(&types.PackagerConfig{DeployOpts: types.ZarfDeployOptions{PackagePath: url, Insecure: true, Components: tt.components}})
+			require.NoError(t, err)
+			defer dst.ClearTempPaths()
+			require.NoError(t, dst.handleOCIPackage())
+
+			// Only the selected components and the blobs of their images are pulled
+			var wantBlobs []string
+			for _, component := range pkg.Components {
+				pulled := !utils.InvalidPath(filepath.Join(dst.tmp.Components, component.Name))
+				selected := false
+				for _, name := range tt.expected {
+					selected = selected || name == component.Name
+				}
+				require.Equal(t, selected, pulled, component.Name)
+
+				if img, ok := componentImages[component.Name]; ok && selected {
+					wantBlobs = append(wantBlobs, imageBlobs(t, img)...)
+				}
+			}
+
+			var gotBlobs []string
+			if !utils.InvalidPath(filepath.Join(dst.tmp.Images, "blobs", "sha256")) {
+				entries, err := os.ReadDir(filepath.Join(dst.tmp.Images, "blobs", "sha256"))
+				require.NoError(t, err)
+				for _, entry := range entries {
+					gotBlobs = append(gotBlobs, entry.Name())
+				}
+			}
+			require.ElementsMatch(t, wantBlobs, gotBlobs)
+		})
+	}
+}
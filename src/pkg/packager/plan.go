@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/internal/packager/template"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	goyaml "github.com/goccy/go-yaml"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// planDeployment reports the changes deploying the package would make without changing the cluster.
+func (p *Packager) planDeployment() error {
+	message.Debug("packager.planDeployment()")
+
+	if p.cfg.IsInitConfig {
+		return fmt.Errorf("a dry run is not supported for init packages")
+	}
+
+	var err error
+	if p.cluster == nil {
+		p.cluster, err = cluster.NewClusterWithWait(30 * time.Second)
+		if err != nil {
+			return fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		}
+	}
+
+	if err := p.checkDifferentialBase(); err != nil {
+		return err
+	}
+
+	if err := p.setActiveVariables(); err != nil {
+		return fmt.Errorf("unable to set the active variables: %w", err)
+	}
+
+	// Load the state without modifying the cluster (unlike getUpdatedValueTemplate in YOLO mode)
+	state, err := p.cluster.LoadZarfState()
+	if err != nil && !p.cfg.Pkg.Metadata.YOLO {
+		return fmt.Errorf("unable to load the Zarf State from the Kubernetes cluster: %w", err)
+	}
+	if state.Distro == "" {
+		if !p.cfg.Pkg.Metadata.YOLO {
+			return fmt.Errorf("unable to load the Zarf State from the Kubernetes cluster, has zarf init been run?")
+		}
+		state.Distro = "YOLO"
+	}
+	p.cfg.State = state
+
+	valueTemplate, err = template.Generate(p.cfg)
+	if err != nil {
+		return fmt.Errorf("unable to generate the value template: %w", err)
+	}
+
+	plan := types.DeployPlan{
+		Package: p.cfg.Pkg.Metadata.Name,
+		Version: p.cfg.Pkg.Metadata.Version,
+	}
+
+	for _, component := range p.getValidComponents() {
+		componentPlan, err := p.planComponent(component)
+		if err != nil {
+			return fmt.Errorf("unable to plan component %s: %w", component.Name, err)
+		}
+		plan.Components = append(plan.Components, componentPlan)
+	}
+
+	text, err := goyaml.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("unable to generate the deployment plan: %w", err)
+	}
+
+	message.SuccessF("Zarf dry run complete, no changes were made to the cluster")
+
+	// Write the plan to stdout so it can be captured as an artifact
+	fmt.Println(string(text))
+
+	return nil
+}
+
+// planComponent reports the changes deploying a single component would make.
+func (p *Packager) planComponent(component types.ZarfComponent) (plan types.ComponentPlan, err error) {
+	message.HeaderInfof("📋 %s COMPONENT", component.Name)
+
+	plan.Name = component.Name
+
+	componentPath, err := p.createComponentPaths(component)
+	if err != nil {
+		return plan, fmt.Errorf("unable to create the component paths: %w", err)
+	}
+
+	for _, script := range component.Scripts.Before {
+		plan.Scripts = append(plan.Scripts, "before: "+script)
+	}
+	for _, script := range component.Scripts.After {
+		plan.Scripts = append(plan.Scripts, "after: "+script)
+	}
+	for _, action := range component.Actions.OnDeploy.Before {
+		plan.Scripts = append(plan.Scripts, "onDeploy.before: "+action.Cmd)
+	}
+	for _, action := range component.Actions.OnDeploy.After {
+		plan.Scripts = append(plan.Scripts, "onDeploy.after: "+action.Cmd)
+	}
+
+	for _, file := range component.Files {
+		plan.Files = append(plan.Files, file.Target)
+		for _, link := range file.Symlinks {
+			plan.Files = append(plan.Files, fmt.Sprintf("%s -> %s", link, file.Target))
+		}
+	}
+
+	registry := config.GetRegistry(p.cfg.State)
+	for _, image := range component.Images {
+		target, err := utils.SwapHostWithoutChecksum(image, registry)
+		if err != nil {
+			return plan, fmt.Errorf("unable to determine the target for image %s: %w", image, err)
+		}
+		plan.Images = append(plan.Images, fmt.Sprintf("%s -> %s", image, target))
+	}
+
+	gitClient := git.New(p.cfg.State.GitServer)
+	for _, repo := range component.Repos {
+		target, err := gitClient.TransformURL(repo)
+		if err != nil {
+			return plan, fmt.Errorf("unable to determine the target for repo %s: %w", repo, err)
+		}
+		plan.Repos = append(plan.Repos, fmt.Sprintf("%s -> %s", repo, target))
+	}
+
+	for _, data := range component.DataInjections {
+		target := data.Target.Selector
+		if data.Target.PVC != "" {
+			target = "pvc/" + data.Target.PVC
+		}
+		plan.DataInjections = append(plan.DataInjections, fmt.Sprintf("%s -> %s/%s:%s",
+			data.Source, data.Target.Namespace, target, data.Target.Path))
+	}
+
+	namespaces := []string{}
+
+	for _, chart := range component.Charts {
+		// Template the values files the same way a deployment would
+		for idx := range chart.ValuesFiles {
+			chartValueName := helm.StandardName(componentPath.Values, chart) + "-" + strconv.Itoa(idx)
+			if err := valueTemplate.Apply(component, chartValueName, false); err != nil {
+				return plan, err
+			}
+		}
+
+		helmCfg := &helm.Helm{
+			BasePath:  componentPath.Base,
+			Chart:     chart,
+			Component: component,
+			Cfg:       p.cfg,
+			Cluster:   p.cluster,
+		}
+
+		release, added, err := helmCfg.PlanChart()
+		if err != nil {
+			return plan, err
+		}
+		plan.Releases = append(plan.Releases, release)
+		namespaces = append(namespaces, added...)
+	}
+
+	for _, manifest := range component.Manifests {
+		for idx := range manifest.Kustomizations {
+			manifest.Files = append(manifest.Files, fmt.Sprintf("kustomization-%s-%d.yaml", manifest.Name, idx))
+		}
+
+		if manifest.Namespace == "" {
+			manifest.Namespace = corev1.NamespaceDefault
+		}
+
+		helmCfg := helm.Helm{
+			BasePath:  componentPath.Manifests,
+			Component: component,
+			Cfg:       p.cfg,
+			Cluster:   p.cluster,
+		}
+
+		release, added, err := helmCfg.PlanManifests(manifest)
+		if err != nil {
+			return plan, err
+		}
+		plan.Releases = append(plan.Releases, release)
+		namespaces = append(namespaces, added...)
+	}
+
+	plan.Namespaces = utils.Unique(namespaces)
+
+	return plan, nil
+}
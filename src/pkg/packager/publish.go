@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+)
+
+// Publish pushes a local Zarf package to an OCI registry.
+func (p *Packager) Publish() error {
+	message.Debugf("packager.Publish(%s)", p.cfg.PublishOpts.Reference)
+
+	if IsOCIURL(p.cfg.DeployOpts.PackagePath) {
+		return fmt.Errorf("the package to publish must be a local package, not %s", p.cfg.DeployOpts.PackagePath)
+	}
+
+	if !IsOCIURL(p.cfg.PublishOpts.Reference) {
+		return fmt.Errorf("the publish destination must begin with %s", OCIURLPrefix)
+	}
+
+	if err := p.loadZarfPkg(); err != nil {
+		return fmt.Errorf("unable to load the package: %w", err)
+	}
+
+	// Default the tag to the package version and architecture so multiple architectures can share a repository
+	arch := p.cfg.Pkg.Build.Architecture
+	if arch == "" {
+		arch = config.GetArch()
+	}
+	tag := arch
+	if p.cfg.Pkg.Metadata.Version != "" {
+		tag = fmt.Sprintf("%s-%s", p.cfg.Pkg.Metadata.Version, arch)
+	}
+
+	ref, err := parseOCIReference(p.cfg.PublishOpts.Reference, tag, p.cfg.PublishOpts.Insecure)
+	if err != nil {
+		return err
+	}
+
+	return p.pushOCIPackage(ref)
+}
@@ -7,12 +7,15 @@ package packager
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
 	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/internal/packager/images"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/types"
 	corev1 "k8s.io/api/core/v1"
@@ -62,11 +65,37 @@ func (p *Packager) Remove(packageName string) error {
 		}
 	}
 
+	// Refuse to remove a component that a component staying behind still depends on
+	if err := checkRemovalDependencies(deployedPackage, requestedComponents); err != nil {
+		spinner.Errorf(err, "Unable to remove the requested components")
+
+		return err
+	}
+
+	// Track the images and repos still used by other deployed components so shared artifacts are kept
+	inUse, err := p.artifactsInUse(packageName, requestedComponents)
+	if err != nil {
+		return err
+	}
+
 	// Loop through the deployed components (in reverse order) check if they were requested and remove them if so
 	for i := len(deployedPackage.DeployedComponents) - 1; i >= 0; i-- {
 		installedComponent := deployedPackage.DeployedComponents[i]
 
 		if slices.Contains(requestedComponents, installedComponent.Name) {
+			// The actions come from the definition of the component in the deployed package
+			var onRemove types.ZarfComponentActionSet
+			for _, component := range deployedPackage.Data.Components {
+				if component.Name == installedComponent.Name {
+					onRemove = component.Actions.OnRemove
+				}
+			}
+
+			if err := p.runActions(onRemove.Before); err != nil {
+				p.runFailureActions(onRemove.OnFailure)
+				return fmt.Errorf("unable to run the onRemove before actions of component %s: %w", installedComponent.Name, err)
+			}
+
 			for h := len(installedComponent.InstalledCharts) - 1; h >= 0; h-- {
 				installedChart := installedComponent.InstalledCharts[h]
 
@@ -77,6 +106,7 @@ func (p *Packager) Remove(packageName string) error {
 				if err != nil {
 					message.Errorf(err, "Unable to remove the installed helm chart (%s) from the namespace (%s) of component (%s) (were dependent components removed first?)",
 						installedChart.ChartName, installedChart.Namespace, installedComponent.Name)
+					p.runFailureActions(onRemove.OnFailure)
 
 					return err
 				}
@@ -86,6 +116,14 @@ func (p *Packager) Remove(packageName string) error {
 				p.updatePackageSecret(deployedPackage, secretName)
 			}
 
+			// Clean up (or report) the images, repos, files and symlinks the component produced
+			p.cleanupComponentArtifacts(packageName, installedComponent, inUse, spinner)
+
+			if err := p.runActions(onRemove.After); err != nil {
+				p.runFailureActions(onRemove.OnFailure)
+				return fmt.Errorf("unable to run the onRemove after actions of component %s: %w", installedComponent.Name, err)
+			}
+
 			// Remove the component we just removed from the array
 			deployedPackage.DeployedComponents = append(deployedPackage.DeployedComponents[:i], deployedPackage.DeployedComponents[i+1:]...)
 		}
@@ -93,6 +131,9 @@ func (p *Packager) Remove(packageName string) error {
 		if len(deployedPackage.DeployedComponents) == 0 {
 			// All the installed components were deleted, there for this package is no longer actually deployed
 			_ = p.cluster.Kube.DeleteSecret(packageSecret)
+			_ = p.cluster.DeletePackageVariables(packageName)
+			_ = p.cluster.DeletePackageGenerations(deployedPackage)
+			_ = p.cluster.DeletePackageImageDigests(packageName)
 		} else {
 			p.updatePackageSecret(deployedPackage, secretName)
 		}
@@ -101,6 +142,144 @@ func (p *Packager) Remove(packageName string) error {
 	return nil
 }
 
+// checkRemovalDependencies returns an error if a deployed component that is not being removed depends on one that is.
+func checkRemovalDependencies(deployedPackage types.DeployedPackage, requestedComponents []string) error {
+	for _, deployedComponent := range deployedPackage.DeployedComponents {
+		if slices.Contains(requestedComponents, deployedComponent.Name) {
+			continue
+		}
+
+		for _, component := range deployedPackage.Data.Components {
+			if component.Name != deployedComponent.Name {
+				continue
+			}
+
+			for _, dependency := range component.DependsOn {
+				if slices.Contains(requestedComponents, dependency) {
+					return fmt.Errorf("component %s is still deployed and depends on component %s, remove it first or include it in --components", component.Name, dependency)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// artifactsInUse returns the images and repos referenced by deployed components that are not being removed.
+func (p *Packager) artifactsInUse(packageName string, requestedComponents []string) (map[string]bool, error) {
+	inUse := map[string]bool{}
+
+	deployedPackages, err := p.cluster.GetDeployedZarfPackages()
+	if err != nil {
+		return inUse, fmt.Errorf("unable to get the deployed packages: %w", err)
+	}
+
+	for _, deployedPackage := range deployedPackages {
+		for _, component := range deployedPackage.DeployedComponents {
+			if deployedPackage.Name == packageName && slices.Contains(requestedComponents, component.Name) {
+				continue
+			}
+			for _, image := range component.Images {
+				inUse[image] = true
+			}
+			for _, repo := range component.Repos {
+				inUse[repo] = true
+			}
+		}
+	}
+
+	return inUse, nil
+}
+
+// cleanupComponentArtifacts removes the artifacts a component produced when --purge is set, or notes what was left behind.
+func (p *Packager) cleanupComponentArtifacts(packageName string, component types.DeployedComponent, inUse map[string]bool, spinner *message.Spinner) {
+	var imgList, repos []string
+	for _, image := range component.Images {
+		if !inUse[image] {
+			imgList = append(imgList, image)
+		}
+	}
+	for _, repo := range component.Repos {
+		if !inUse[repo] {
+			repos = append(repos, repo)
+		}
+	}
+
+	total := len(imgList) + len(repos) + len(component.Files) + len(component.Symlinks)
+	if total == 0 {
+		return
+	}
+
+	if !p.cfg.RemoveOpts.Purge {
+		message.Notef("The %s component left behind %d images, %d repos, %d files and %d symlinks, use --purge to remove them",
+			component.Name, len(imgList), len(repos), len(component.Files), len(component.Symlinks))
+		return
+	}
+
+	for _, link := range component.Symlinks {
+		spinner.Updatef("Removing symlink %s from the (%s) component", link, component.Name)
+		if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+			message.Warnf("Unable to remove the symlink %s: %s", link, err.Error())
+		}
+	}
+
+	for _, file := range component.Files {
+		spinner.Updatef("Removing file %s from the (%s) component", file, component.Name)
+		if err := os.RemoveAll(file); err != nil {
+			message.Warnf("Unable to remove the file %s: %s", file, err.Error())
+		}
+	}
+
+	if len(imgList) == 0 && len(repos) == 0 {
+		return
+	}
+
+	state, err := p.cluster.LoadZarfState()
+	if err != nil {
+		message.Warnf("Unable to load the Zarf State to remove images and repos: %s", err.Error())
+		return
+	}
+
+	if len(imgList) > 0 {
+		spinner.Updatef("Removing %d images from the (%s) component", len(imgList), component.Name)
+		var imagesInUse []string
+		for image := range inUse {
+			imagesInUse = append(imagesInUse, image)
+		}
+
+		imgConfig := images.ImgConfig{
+			ImgList: imgList,
+			RegInfo: state.RegistryInfo,
+			InUse:   imagesInUse,
+		}
+		if err := imgConfig.DeleteFromZarfRegistry(); err != nil {
+			message.Warnf("Unable to remove the images for the %s component: %s", component.Name, err.Error())
+		}
+		if err := p.cluster.RemoveImageDigests(packageName, imgList); err != nil {
+			message.Warnf("Unable to remove the image digests for the %s component: %s", component.Name, err.Error())
+		}
+	}
+
+	if len(repos) > 0 {
+		if !state.GitServer.InternalServer {
+			message.Warnf("Unable to remove the repos for the %s component, removing repos is only supported on the Zarf git server", component.Name)
+			return
+		}
+
+		gitClient := git.New(state.GitServer)
+		for _, repo := range repos {
+			spinner.Updatef("Removing repo %s from the (%s) component", repo, component.Name)
+			repoName, err := gitClient.TransformURLtoRepoName(repo)
+			if err == nil {
+				err = gitClient.DeleteRepo(repoName)
+			}
+			if err != nil {
+				message.Warnf("Unable to remove the repo %s: %s", repo, err.Error())
+			}
+		}
+	}
+}
+
 func (p *Packager) updatePackageSecret(deployedPackage types.DeployedPackage, secretName string) {
 	// Save the new secret with the removed components removed from the secret
 	newPackageSecret := p.cluster.Kube.GenerateSecret(cluster.ZarfNamespace, secretName, corev1.SecretTypeOpaque)
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/cluster"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// Rollback restores every chart of a deployed package to the helm revisions recorded for a previous generation.
+// If generation is 0 the package is rolled back to the generation before the current one.
+func (p *Packager) Rollback(packageName string, generation int) error {
+	spinner := message.NewProgressSpinner("Rolling back zarf package %s", packageName)
+	defer spinner.Stop()
+
+	var err error
+	if p.cluster == nil {
+		p.cluster, err = cluster.NewClusterWithWait(30 * time.Second)
+		if err != nil {
+			return fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		}
+	}
+
+	deployedPackage, err := p.cluster.GetDeployedPackage(packageName)
+	if err != nil {
+		return fmt.Errorf("unable to load the deployed package %s: %w", packageName, err)
+	}
+
+	if generation == 0 {
+		generation = deployedPackage.Generation - 1
+	}
+
+	if generation == deployedPackage.Generation {
+		return fmt.Errorf("package %s is already at generation %d", packageName, generation)
+	}
+
+	var target *types.DeployedPackageGeneration
+	var available []int
+	for idx, entry := range deployedPackage.History {
+		available = append(available, entry.Generation)
+		if entry.Generation == generation {
+			target = &deployedPackage.History[idx]
+		}
+	}
+
+	if target == nil {
+		return fmt.Errorf("generation %d of package %s was not found, available generations are %v", generation, packageName, available)
+	}
+
+	// Warn about releases that were added after the target generation, they are left in place
+	targetCharts := map[string]bool{}
+	for _, component := range target.Components {
+		for _, chart := range component.InstalledCharts {
+			targetCharts[chart.Namespace+"/"+chart.ChartName] = true
+		}
+	}
+	for _, component := range deployedPackage.DeployedComponents {
+		for _, chart := range component.InstalledCharts {
+			if !targetCharts[chart.Namespace+"/"+chart.ChartName] {
+				message.Warnf("The chart (%s) in the (%s) component did not exist in generation %d and will not be changed",
+					chart.ChartName, component.Name, generation)
+			}
+		}
+	}
+
+	// Keep the artifacts recorded for each component, only the chart revisions change
+	currentComponents := map[string]types.DeployedComponent{}
+	for _, component := range deployedPackage.DeployedComponents {
+		currentComponents[component.Name] = component
+	}
+
+	rolledBack := make([]types.DeployedComponent, len(target.Components))
+
+	// Roll back the components (and their charts) in reverse order, just like a removal
+	for i := len(target.Components) - 1; i >= 0; i-- {
+		targetComponent := target.Components[i]
+
+		component := currentComponents[targetComponent.Name]
+		component.Name = targetComponent.Name
+		component.InstalledCharts = make([]types.InstalledChart, len(targetComponent.InstalledCharts))
+
+		for h := len(targetComponent.InstalledCharts) - 1; h >= 0; h-- {
+			chart := targetComponent.InstalledCharts[h]
+			component.InstalledCharts[h] = chart
+
+			if chart.Revision == 0 {
+				message.Warnf("No revision was recorded for the chart (%s) in the (%s) component, skipping", chart.ChartName, targetComponent.Name)
+				continue
+			}
+
+			spinner.Updatef("Rolling back chart (%s) from the (%s) component to revision %d", chart.ChartName, targetComponent.Name, chart.Revision)
+
+			helmCfg := helm.Helm{}
+			revision, err := helmCfg.RollbackChart(chart.Namespace, chart.ChartName, chart.Revision, spinner)
+			if err != nil {
+				return fmt.Errorf("unable to roll back the chart (%s) in the namespace (%s) of component (%s): %w",
+					chart.ChartName, chart.Namespace, targetComponent.Name, err)
+			}
+
+			component.InstalledCharts[h].Revision = revision
+		}
+
+		rolledBack[i] = component
+	}
+
+	// Record the rollback as a new generation with the package definition of the target so it can be undone as well
+	pkg, err := p.cluster.GetPackageGeneration(packageName, generation)
+	if err != nil {
+		pkg = deployedPackage.Data
+		message.Warnf("Unable to load the package definition of generation %d of package %s, keeping the definition of version %s: %s",
+			generation, packageName, pkg.Metadata.Version, err.Error())
+	}
+
+	variables := p.restoreVariables(packageName, target.Variables)
+	if err := p.cluster.RecordPackageDeployment(pkg, rolledBack, variables); err != nil {
+		return fmt.Errorf("unable to record the rollback of package %s: %w", packageName, err)
+	}
+
+	// Later deploys reuse the saved variables, so they have to match the rolled back generation too
+	if err := p.cluster.RecordPackageVariables(packageName, variables); err != nil {
+		message.Warnf("Unable to restore the saved variables of package %s: %s", packageName, err.Error())
+	}
+
+	spinner.Successf("Rolled back zarf package %s to generation %d (version %s)", packageName, generation, pkg.Metadata.Version)
+	return nil
+}
+
+// restoreVariables returns the variables of a previous generation. The values of sensitive variables are masked in the
+// history, so their current values are kept.
+func (p *Packager) restoreVariables(packageName string, variables map[string]string) map[string]string {
+	current, err := p.cluster.GetPackageVariables(packageName)
+	if err != nil {
+		message.Debugf("Unable to load the saved variables of package %s: %s", packageName, err.Error())
+	}
+
+	restored := make(map[string]string, len(variables))
+	for key, value := range variables {
+		if value != config.ZarfMaskedValue {
+			restored[key] = value
+		} else if currentValue, ok := current[key]; ok {
+			message.Warnf("The value of the sensitive variable %s is not recorded in the history, keeping its current value", key)
+			restored[key] = currentValue
+		}
+	}
+
+	return restored
+}
@@ -63,16 +63,7 @@ func (p *Packager) loopScriptUntilSuccess(script string, scripts types.ZarfCompo
 			ctx, cancel = context.WithTimeout(context.Background(), duration)
 			defer cancel()
 
-			var shell string
-			var shellArgs string
-
-			if runtime.GOOS == "windows" {
-				shell = "powershell"
-				shellArgs = "-Command"
-			} else {
-				shell = "sh"
-				shellArgs = "-c"
-			}
+			shell, shellArgs := getShell()
 
 			output, errOut, err := utils.ExecCommandWithContext(ctx, scripts.ShowOutput, shell, shellArgs, script)
 
@@ -97,6 +88,15 @@ func (p *Packager) loopScriptUntilSuccess(script string, scripts types.ZarfCompo
 	}
 }
 
+// getShell returns the shell (and the argument to pass a command to it) used to run scripts and actions.
+func getShell() (string, string) {
+	if runtime.GOOS == "windows" {
+		return "powershell", "-Command"
+	}
+
+	return "sh", "-c"
+}
+
 // Perform some basic string mutations to make scripts more useful.
 func (p *Packager) scriptMutation(script string) (string, error) {
 
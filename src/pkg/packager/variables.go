@@ -6,9 +6,12 @@ package packager
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/validate"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/types"
 )
@@ -62,33 +65,116 @@ func (p *Packager) setActiveVariables() error {
 		p.cfg.SetVariableMap[strings.ToUpper(key)] = value
 	}
 
+	// Reuse the values from the last deployment of this package unless they were --set
+	previousVariables := p.loadPreviousVariables()
+	var reused []string
+
 	for _, variable := range p.cfg.Pkg.Variables {
-		_, present := p.cfg.SetVariableMap[variable.Name]
+		value, present := p.cfg.SetVariableMap[variable.Name]
+
+		if previousValue, ok := previousVariables[variable.Name]; ok && !present {
+			// File variables were already replaced by the contents of their file
+			if variable.Type != "file" {
+				if err := validate.VariableValue(variable, previousValue); err != nil {
+					return fmt.Errorf("the value from the previous deployment is no longer valid, it must be --set: %w", err)
+				}
+			}
 
-		// Variable is present, no need to continue checking
-		if present {
+			p.cfg.SetVariableMap[variable.Name] = previousValue
+			reused = append(reused, variable.Name)
 			continue
 		}
 
-		// First set default (may be overridden by prompt)
-		p.cfg.SetVariableMap[variable.Name] = variable.Default
+		if !present {
+			// Generated values are random strings, so they are not validated
+			if variable.AutoGenerate && variable.Default == "" {
+				p.cfg.SetVariableMap[variable.Name] = utils.RandomString(config.ZarfGeneratedPasswordLen)
+				continue
+			}
 
-		// Variable is set to prompt the user
-		if variable.Prompt && !config.CommonOptions.Confirm {
-			// Prompt the user for the variable
-			val, err := p.promptVariable(variable)
+			// First set default (may be overridden by prompt)
+			value = variable.Default
 
-			if err != nil {
-				return err
+			// Variable is set to prompt the user
+			if variable.Prompt && !config.CommonOptions.Confirm {
+				// Prompt the user for the variable
+				val, err := p.promptVariable(variable)
+
+				if err != nil {
+					return err
+				}
+
+				value = val
 			}
+		}
 
-			p.cfg.SetVariableMap[variable.Name] = val
+		// Check every value before anything is deployed
+		if err := validate.VariableValue(variable, value); err != nil {
+			return err
 		}
+
+		// File variables are set to the contents of the file
+		if variable.Type == "file" && value != "" {
+			contents, err := os.ReadFile(value)
+			if err != nil {
+				return fmt.Errorf("unable to read the file for variable %s: %w", variable.Name, err)
+			}
+			value = string(contents)
+		}
+
+		p.cfg.SetVariableMap[variable.Name] = value
+	}
+
+	if len(reused) > 0 {
+		message.Notef("Reusing the values of %s from the previous deployment of this package, use --set to change them", strings.Join(reused, ", "))
 	}
 
 	return nil
 }
 
+// loadPreviousVariables returns the variable values saved by the last deployment of this package (if there was one).
+func (p *Packager) loadPreviousVariables() map[string]string {
+	// The package can only have been deployed before to the cluster it is being deployed to
+	if len(p.cfg.Pkg.Variables) == 0 || p.cluster == nil {
+		return nil
+	}
+
+	previousVariables, err := p.cluster.GetPackageVariables(p.cfg.Pkg.Metadata.Name)
+	if err != nil {
+		message.Debugf("No variable values were saved by a previous deployment of this package: %s", err.Error())
+		return nil
+	}
+
+	return previousVariables
+}
+
+// packageVariableValues returns the values of the variables the package declares or sets with its actions, leaving out
+// any other --set keys so they are not saved with the package.
+func (p *Packager) packageVariableValues() map[string]string {
+	names := make(map[string]bool)
+	for _, variable := range p.cfg.Pkg.Variables {
+		names[variable.Name] = true
+	}
+	for _, component := range p.cfg.Pkg.Components {
+		onDeploy := component.Actions.OnDeploy
+		for _, actions := range [][]types.ZarfComponentAction{onDeploy.Before, onDeploy.After, onDeploy.OnFailure} {
+			for _, action := range actions {
+				if action.SetVariable != "" {
+					names[action.SetVariable] = true
+				}
+			}
+		}
+	}
+
+	values := make(map[string]string)
+	for name, value := range p.cfg.SetVariableMap {
+		if names[name] {
+			values[name] = value
+		}
+	}
+	return values
+}
+
 // injectImportedVariable determines if an imported package variable exists in the active config and adds it if not.
 func (p *Packager) injectImportedVariable(importedVariable types.ZarfPackageVariable) {
 	presentInActive := false
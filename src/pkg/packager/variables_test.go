@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageVariableValues(t *testing.T) {
+	p := &Packager{cfg: &types.PackagerConfig{
+		Pkg: types.ZarfPackage{
+			Variables: []types.ZarfPackageVariable{{Name: "DOMAIN"}, {Name: "REPLICAS"}},
+			Components: []types.ZarfComponent{{
+				Name: "database",
+				Actions: types.ZarfComponentActions{
+					OnDeploy: types.ZarfComponentActionSet{
+						After: []types.ZarfComponentAction{{Cmd: "cat password", SetVariable: "DB_PASSWORD"}},
+					},
+					OnRemove: types.ZarfComponentActionSet{
+						Before: []types.ZarfComponentAction{{Cmd: "echo removed", SetVariable: "REMOVED"}},
+					},
+				},
+			}},
+		},
+		SetVariableMap: map[string]string{
+			"DOMAIN":      "example.com",
+			"DB_PASSWORD": "secret",
+			"UNDECLARED":  "--set typo",
+			"REMOVED":     "not an onDeploy action",
+		},
+	}}
+
+	// Only the declared variables and the ones set by onDeploy actions are kept
+	require.Equal(t, map[string]string{"DOMAIN": "example.com", "DB_PASSWORD": "secret"}, p.packageVariableValues())
+
+	// Without a cluster there is nothing to reuse
+	require.Nil(t, p.loadPreviousVariables())
+}
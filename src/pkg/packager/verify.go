@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+)
+
+// Verify checks every file in a package against its checksums (and its signature if a public key was given).
+func (p *Packager) Verify() error {
+	// Loading the package validates the checksums and the signature
+	if err := p.loadZarfPkg(); err != nil {
+		return fmt.Errorf("unable to load the package: %w", err)
+	}
+
+	if p.cfg.Pkg.Build.AggregateChecksum == "" {
+		return fmt.Errorf("the package does not contain a %s, it was likely created by an older version of Zarf", config.ZarfChecksumsTxt)
+	}
+
+	message.SuccessF("Zarf package %s verified", p.cfg.Pkg.Metadata.Name)
+	return nil
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package utils provides generic helper functions.
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"strings"
+
+	"github.com/sigstore/cosign/cmd/cosign/cli/generate"
+	"github.com/sigstore/cosign/pkg/cosign"
+	sigs "github.com/sigstore/cosign/pkg/signature"
+)
+
+// CosignSignBlob signs a file with a cosign private key and writes the base64 encoded signature (the same format as
+// `cosign sign-blob`). If no key password is given it is read from COSIGN_PASSWORD or prompted for.
+func CosignSignBlob(blobPath, signaturePath, keyPath, keyPassword string) error {
+	var passFunc cosign.PassFunc = generate.GetPass
+	if keyPassword != "" {
+		passFunc = func(bool) ([]byte, error) {
+			return []byte(keyPassword), nil
+		}
+	}
+
+	signer, err := sigs.SignerFromKeyRef(context.TODO(), keyPath, passFunc)
+	if err != nil {
+		return err
+	}
+
+	blob, err := os.Open(blobPath)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	signature, err := signer.SignMessage(blob)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(signaturePath, []byte(base64.StdEncoding.EncodeToString(signature)), 0644)
+}
+
+// CosignVerifyBlob verifies the base64 encoded signature of a file using a cosign public key.
+func CosignVerifyBlob(blobPath, signaturePath, keyPath string) error {
+	verifier, err := sigs.LoadPublicKey(context.TODO(), keyPath)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := os.ReadFile(signaturePath)
+	if err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return err
+	}
+
+	blob, err := os.Open(blobPath)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	return verifier.VerifySignature(bytes.NewReader(signature), blob)
+}
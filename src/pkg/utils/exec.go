@@ -29,6 +29,12 @@ func ExecCommandWithContext(ctx context.Context, showLogs bool, commandName stri
 
 // ExecCommandWithContextAndDir executes a given command with args in the specified directory.
 func ExecCommandWithContextAndDir(ctx context.Context, dir string, showLogs bool, commandName string, args ...string) (string, string, error) {
+	return ExecCommandWithContextDirAndEnv(ctx, dir, nil, showLogs, commandName, args...)
+}
+
+// ExecCommandWithContextDirAndEnv executes a given command with args in the specified directory, adding the given
+// environment variables (KEY=value) to the environment of the current process.
+func ExecCommandWithContextDirAndEnv(ctx context.Context, dir string, env []string, showLogs bool, commandName string, args ...string) (string, string, error) {
 	if showLogs {
 		fmt.Println()
 		fmt.Printf("  %s", colorGreen)
@@ -42,43 +48,42 @@ func ExecCommandWithContextAndDir(ctx context.Context, dir string, showLogs bool
 
 	cmd := exec.CommandContext(ctx, commandName, args...)
 
-	env := os.Environ()
-	cmd.Env = env
+	cmd.Env = append(os.Environ(), env...)
 	cmd.Dir = dir
 
 	var stdoutBuf, stderrBuf bytes.Buffer
 	stdoutIn, _ := cmd.StdoutPipe()
 	stderrIn, _ := cmd.StderrPipe()
 
+	// Always capture the output, only stream it to the terminal if the logs are shown
 	var errStdout, errStderr error
-	stdout := io.MultiWriter(os.Stdout, &stdoutBuf)
-	stderr := io.MultiWriter(os.Stderr, &stderrBuf)
+	var stdout, stderr io.Writer = &stdoutBuf, &stderrBuf
+	if showLogs {
+		stdout = io.MultiWriter(os.Stdout, &stdoutBuf)
+		stderr = io.MultiWriter(os.Stderr, &stderrBuf)
+	}
 
 	if err := cmd.Start(); err != nil {
 		return "", "", err
 	}
 
-	if showLogs {
-		var wg sync.WaitGroup
-		wg.Add(1)
+	var wg sync.WaitGroup
+	wg.Add(1)
 
-		go func() {
-			_, errStdout = io.Copy(stdout, stdoutIn)
-			wg.Done()
-		}()
+	go func() {
+		_, errStdout = io.Copy(stdout, stdoutIn)
+		wg.Done()
+	}()
 
-		_, errStderr = io.Copy(stderr, stderrIn)
-		wg.Wait()
-	}
+	_, errStderr = io.Copy(stderr, stderrIn)
+	wg.Wait()
 
 	if err := cmd.Wait(); err != nil {
-		return "", "", err
+		return stdoutBuf.String(), stderrBuf.String(), err
 	}
 
-	if showLogs {
-		if errStdout != nil || errStderr != nil {
-			return "", "", errors.New("unable to capture stdOut or stdErr")
-		}
+	if errStdout != nil || errStderr != nil {
+		return "", "", errors.New("unable to capture stdOut or stdErr")
 	}
 
 	return stdoutBuf.String(), stderrBuf.String(), nil
@@ -45,6 +45,26 @@ func SwapHostWithoutChecksum(src string, targetHost string) (string, error) {
 	return fmt.Sprintf("%s/%s%s", targetHost, image.Path, image.TagOrDigest), nil
 }
 
+// SwapHostWithDigest Perform the same replacement as SwapHost but references the image by the given digest instead of its tag.
+func SwapHostWithDigest(src string, targetHost string, digest string) (string, error) {
+	image, err := parseImageURL(src)
+	if err != nil {
+		return "", err
+	}
+
+	return SwapHost(fmt.Sprintf("%s@%s", image.Name, digest), targetHost)
+}
+
+// NormalizeImageRef returns the fully qualified reference of an image (e.g. docker.io/library/nginx:1.23 for nginx:1.23).
+func NormalizeImageRef(src string) (string, error) {
+	image, err := parseImageURL(src)
+	if err != nil {
+		return "", err
+	}
+
+	return image.Reference, nil
+}
+
 func parseImageURL(src string) (out Image, err error) {
 	ref, err := reference.ParseAnyReference(src)
 	if err != nil {
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package utils provides generic helper functions.
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDigest = "sha256:b3d5ec65a3cf2b3d2bf1b2dd0a9e5ea5bbc5d4e5bd4a0e3a0b4fd0c5c8e2f1a9"
+
+func TestNormalizeImageRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    string
+		expected string
+		err      bool
+	}{
+		{name: "short name", image: "nginx:1.23", expected: "docker.io/library/nginx:1.23"},
+		{name: "no tag", image: "nginx", expected: "docker.io/library/nginx"},
+		{name: "docker hub org", image: "defenseunicorns/zarf-agent:v0.24.0", expected: "docker.io/defenseunicorns/zarf-agent:v0.24.0"},
+		{name: "other registry", image: "ghcr.io/stefanprodan/podinfo:6.3.3", expected: "ghcr.io/stefanprodan/podinfo:6.3.3"},
+		{name: "registry with port", image: "127.0.0.1:31999/library/nginx:1.23", expected: "127.0.0.1:31999/library/nginx:1.23"},
+		{name: "digest", image: "nginx@" + testDigest, expected: "docker.io/library/nginx@" + testDigest},
+		{name: "tag and digest", image: "nginx:1.23@" + testDigest, expected: "docker.io/library/nginx:1.23@" + testDigest},
+		{name: "invalid", image: "Nginx:1.23", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := NormalizeImageRef(tt.image)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, ref)
+		})
+	}
+}
+
+func TestSwapHostWithDigest(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    string
+		expected string
+		err      bool
+	}{
+		{name: "tag", image: "nginx:1.23", expected: "127.0.0.1:31999/library/nginx-3793515731@" + testDigest},
+		{name: "no tag", image: "nginx", expected: "127.0.0.1:31999/library/nginx-3793515731@" + testDigest},
+		{name: "fully qualified", image: "docker.io/library/nginx:1.23", expected: "127.0.0.1:31999/library/nginx-3793515731@" + testDigest},
+		{name: "existing digest", image: "nginx@sha256:0000000000000000000000000000000000000000000000000000000000000000", expected: "127.0.0.1:31999/library/nginx-3793515731@" + testDigest},
+		{name: "invalid", image: "Nginx:1.23", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := SwapHostWithDigest(tt.image, "127.0.0.1:31999", testDigest)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, ref)
+		})
+	}
+
+	// The digest reference points at the same repository as the tagged image Zarf pushed
+	tagged, err := SwapHost("nginx:1.23", "127.0.0.1:31999")
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:31999/library/nginx-3793515731:1.23", tagged)
+}
@@ -228,3 +228,20 @@ func IsTextFile(path string) (bool, error) {
 
 	return hasText || hasJson || hasXML, nil
 }
+
+// GetDirSize walks through all files in the provided path and returns the total size in bytes.
+func GetDirSize(path string) (int64, error) {
+	var size int64
+
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
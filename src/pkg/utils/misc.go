@@ -34,3 +34,20 @@ func Retry(fn func() error, retries int, delay time.Duration) (err error) {
 
 	return err
 }
+
+// RetryWithBackoff will retry a function until it succeeds or runs out of retries, doubling the delay after each failure.
+func RetryWithBackoff(fn func() error, retries int, delay time.Duration) (err error) {
+	for r := 0; r < retries; r++ {
+		err = fn()
+		if err == nil {
+			break
+		}
+
+		if r < retries-1 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+
+	return err
+}
@@ -15,6 +15,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 )
@@ -109,6 +110,64 @@ func GetAvailablePort() (int, error) {
 	return port, err
 }
 
+// WaitForNetworkEndpoint checks every second until the context is done for the given address to accept tcp connections or,
+// for http and https, to respond with the given status code (any 2xx code if code is 0).
+func WaitForNetworkEndpoint(ctx context.Context, protocol, address string, code int) error {
+	message.Debugf("utils.WaitForNetworkEndpoint(%s, %s, %d)", protocol, address, code)
+
+	switch protocol {
+	case "tcp", "http", "https":
+	default:
+		return fmt.Errorf("unsupported protocol %s, it must be one of tcp, http or https", protocol)
+	}
+
+	for {
+		err := checkNetworkEndpoint(ctx, protocol, address, code)
+		if err == nil {
+			return nil
+		}
+		message.Debugf("%s endpoint %s is not ready yet: %s", protocol, address, err.Error())
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s endpoint %s: %w", protocol, address, err)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func checkNetworkEndpoint(ctx context.Context, protocol, address string, code int) error {
+	if protocol == "tcp" {
+		conn, err := (&net.Dialer{Timeout: 5 * time.Second}).DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	if !strings.HasPrefix(address, protocol+"://") {
+		address = fmt.Sprintf("%s://%s", protocol, address)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, address, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if (code == 0 && resp.StatusCode >= 200 && resp.StatusCode < 300) || resp.StatusCode == code {
+		return nil
+	}
+	return fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+}
+
 func httpGetFile(url string, destinationFile *os.File) {
 	// Get the data
 	resp, err := http.Get(url)
@@ -7,6 +7,7 @@ package test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,10 +34,44 @@ func TestDataInjection(t *testing.T) {
 	assert.Contains(t, stdOut, "this-is-an-example-file.txt")
 	assert.Contains(t, stdOut, ".zarf-injection-")
 
+	// Verify the injected file matches the one in the package
+	expectedSum, err := utils.GetSha256Sum("examples/data-injection/sample-data/this-is-an-example-file.txt")
+	require.NoError(t, err)
+	stdOut, stdErr, err = utils.ExecCommandWithContext(context.TODO(), true, "kubectl", "--namespace=demo", "exec", "deployment/data-injection", "-c=data-injection", "--", "sha256sum", "/test/this-is-an-example-file.txt")
+	require.NoError(t, err, stdOut, stdErr)
+	require.Equal(t, expectedSum, strings.Fields(stdOut)[0])
+
+	// Verify the data injected into the PersistentVolumeClaim is seen by the deployment using the claim
+	stdOut, stdErr, err = utils.ExecCommandWithContext(context.TODO(), true, "kubectl", "--namespace=demo", "exec", "deployment/data-injection-pvc-reader", "--", "sha256sum", "/data/sample-data/this-is-an-example-file.txt")
+	require.NoError(t, err, stdOut, stdErr)
+	require.Equal(t, expectedSum, strings.Fields(stdOut)[0])
+
+	// Verify the helper pod that mounted the claim was removed
+	stdOut, stdErr, err = utils.ExecCommandWithContext(context.TODO(), true, "kubectl", "--namespace=demo", "get", "pods", "--no-headers")
+	require.NoError(t, err, stdOut, stdErr)
+	require.NotContains(t, stdOut, "zarf-data-injection-")
+
 	stdOut, stdErr, err = e2e.execZarfCommand("package", "remove", "data-injection-demo", "--confirm")
 	require.NoError(t, err, stdOut, stdErr)
 }
 
+func TestDataInjectionTimeout(t *testing.T) {
+	t.Log("E2E: Data injection timeout")
+	e2e.setupWithCluster(t)
+	defer e2e.teardown(t)
+
+	stdOut, stdErr, err := e2e.execZarfCommand("package", "create", "src/test/packages/23-data-injection-timeout", "-o", "build", "--confirm")
+	require.NoError(t, err, stdOut, stdErr)
+
+	path := fmt.Sprintf("build/zarf-package-test-data-injection-timeout-%s.tar.zst", e2e.arch)
+	defer e2e.cleanFiles(path)
+
+	// Deploy the injection that never finds its target pod and should time out
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "deploy", path, "--confirm")
+	require.Error(t, err, stdOut, stdErr)
+	require.Contains(t, stdErr, "timed out after 10 seconds")
+}
+
 func runDataInjection(t *testing.T, path string) {
 	// Limit this deploy to 5 minutes
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
@@ -45,4 +80,8 @@ func runDataInjection(t *testing.T, path string) {
 	// Deploy the data injection example
 	stdOut, stdErr, err := utils.ExecCommandWithContext(ctx, true, e2e.zarfBinPath, "package", "deploy", path, "--confirm")
 	require.NoError(t, err, stdOut, stdErr)
+
+	// The data was streamed over the Kubernetes API and verified inside the container
+	require.Contains(t, stdErr, "Injected data into /test")
+	require.NotContains(t, stdErr, "Unable to verify the data injected")
 }
@@ -31,3 +31,34 @@ func TestHelm(t *testing.T) {
 	stdOut, stdErr, err = e2e.execZarfCommand("package", "remove", "test-helm-releasename", "--confirm")
 	require.NoError(t, err, stdOut, stdErr)
 }
+
+func TestHelmVariablePath(t *testing.T) {
+	t.Log("E2E: Helm chart variable paths")
+	e2e.setupWithCluster(t)
+	defer e2e.teardown(t)
+
+	stdOut, stdErr, err := e2e.execZarfCommand("package", "create", "src/test/packages/25-helm-variable-path", "-o", "build", "--confirm")
+	require.NoError(t, err, stdOut, stdErr)
+
+	path := fmt.Sprintf("build/zarf-package-test-helm-variable-path-%s.tar.zst", e2e.arch)
+	defer e2e.cleanFiles(path)
+
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "deploy", path, "--confirm")
+	require.NoError(t, err, stdOut, stdErr)
+
+	// Verify the scoped path only set the replicas of the chart it names
+	kubectlOut, _ := exec.Command("kubectl", "get", "deployment", "-n=helm-variable-path", "scoped-podinfo", "-o=jsonpath={.spec.replicas}").Output()
+	assert.Equal(t, "2", string(kubectlOut))
+	kubectlOut, _ = exec.Command("kubectl", "get", "deployment", "-n=helm-variable-path", "other-podinfo", "-o=jsonpath={.spec.replicas}").Output()
+	assert.Equal(t, "1", string(kubectlOut))
+
+	// Verify the unscoped path set the message of every chart
+	for _, deployment := range []string{"scoped-podinfo", "other-podinfo"} {
+		kubectlOut, _ = exec.Command("kubectl", "get", "deployment", "-n=helm-variable-path", deployment,
+			`-o=jsonpath={.spec.template.spec.containers[0].env[?(@.name=="PODINFO_UI_MESSAGE")].value}`).Output()
+		assert.Equal(t, "set by zarf", string(kubectlOut), deployment)
+	}
+
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "remove", "test-helm-variable-path", "--confirm")
+	require.NoError(t, err, stdOut, stdErr)
+}
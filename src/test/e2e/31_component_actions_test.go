@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package test provides e2e tests for Zarf.
+package test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentActions(t *testing.T) {
+	t.Log("E2E: Testing component actions")
+	e2e.setup(t)
+	defer e2e.teardown(t)
+
+	// Note the create artifact will be created in the package directory, not CWD
+	createArtifact := "examples/component-actions/test-create-before.txt"
+	deployArtifacts := []string{
+		"test-action-dir",
+		"test-deploy-retries.txt",
+		"test-deploy-after.txt",
+		"test-deploy-failure.txt",
+	}
+	allArtifacts := append(deployArtifacts, createArtifact)
+	e2e.cleanFiles(allArtifacts...)
+	defer e2e.cleanFiles(allArtifacts...)
+
+	// Try creating the package to test the onCreate actions
+	stdOut, stdErr, err := e2e.execZarfCommand("package", "create", "examples/component-actions", "--confirm")
+	require.NoError(t, err, stdOut, stdErr)
+	require.FileExists(t, createArtifact)
+
+	// Test to ensure the onDeploy actions are not executed
+	for _, artifact := range deployArtifacts {
+		require.NoFileExists(t, artifact)
+	}
+
+	path := fmt.Sprintf("build/zarf-package-component-actions-%s.tar.zst", e2e.arch)
+
+	// Deploy the actions that should pass
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "deploy", path, "--confirm", "--components=on-deploy")
+	require.NoError(t, err, stdOut, stdErr)
+
+	// The dir and env of the action were used
+	out, err := os.ReadFile("test-action-dir/test-deploy-env.txt")
+	require.NoError(t, err)
+	require.Equal(t, "hello", strings.TrimSpace(string(out)))
+
+	// The action failed twice and succeeded on its second retry
+	out, err = os.ReadFile("test-deploy-retries.txt")
+	require.NoError(t, err)
+	require.Equal(t, 3, strings.Count(string(out), "attempt"))
+
+	// The variable set by a before action was available to an after action
+	out, err = os.ReadFile("test-deploy-after.txt")
+	require.NoError(t, err)
+	require.Equal(t, "meow", strings.TrimSpace(string(out)))
+
+	// Deploy the action that should fail the timeout
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "deploy", path, "--confirm", "--components=timeout")
+	require.Error(t, err, stdOut, stdErr)
+	require.Contains(t, stdErr, "timed out after 1 seconds")
+
+	// Deploy the action that should fail and run its onFailure action
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "deploy", path, "--confirm", "--components=on-failure")
+	require.Error(t, err, stdOut, stdErr)
+	require.FileExists(t, "test-deploy-failure.txt")
+}
+
+func TestComponentActionsSetVariableChart(t *testing.T) {
+	t.Log("E2E: Testing component actions setting a variable for a chart")
+	e2e.setupWithCluster(t)
+	defer e2e.teardown(t)
+
+	path := fmt.Sprintf("build/zarf-package-component-actions-%s.tar.zst", e2e.arch)
+
+	stdOut, stdErr, err := e2e.execZarfCommand("package", "deploy", path, "--confirm", "--components=set-variable-chart")
+	require.NoError(t, err, stdOut, stdErr)
+
+	// The manifest was templated with the output of the before action
+	kubectlOut, err := exec.Command("kubectl", "get", "configmap", "-n=component-actions", "cat-sound", "-o=jsonpath={.data.sound}").Output()
+	require.NoError(t, err)
+	require.Equal(t, "purr", string(kubectlOut))
+
+	stdOut, stdErr, err = e2e.execZarfCommand("package", "remove", "component-actions", "--confirm")
+	require.NoError(t, err, stdOut, stdErr)
+}
@@ -25,6 +25,9 @@ type ZarfComponent struct {
 	// Note: ignores default and required flags
 	Group string `json:"group,omitempty" jsonschema:"description=Create a user selector field based on all components in the same group"`
 
+	// DependsOn lists other components in this package that must be deployed before this component
+	DependsOn []string `json:"dependsOn,omitempty" jsonschema:"description=Names of other components in this package that must be deployed before this component"`
+
 	//Path to cosign publickey for signed online resources
 	CosignKeyPath string `json:"cosignKeyPath,omitempty" jsonschema:"description=Specify a path to a public key to validate signed online resources"`
 
@@ -34,6 +37,9 @@ type ZarfComponent struct {
 	// Scripts are custom commands that run before or after package deployment
 	Scripts ZarfComponentScripts `json:"scripts,omitempty" jsonschema:"description=Custom commands to run before or after package deployment"`
 
+	// Actions are custom commands that run during package create, deploy and remove
+	Actions ZarfComponentActions `json:"actions,omitempty" jsonschema:"description=Custom commands to run at different stages of package create, deploy and remove"`
+
 	// Files are files to place on disk during deploy
 	Files []ZarfFile `json:"files,omitempty" jsonschema:"description=Files to place on disk during package deployment"`
 
@@ -51,6 +57,9 @@ type ZarfComponent struct {
 
 	// Data packages to push into a running cluster
 	DataInjections []ZarfDataInjection `json:"dataInjections,omitempty" jsonschema:"description=Datasets to inject into a pod in the target cluster"`
+
+	// Wait lists conditions to wait for after the component is deployed
+	Wait []ZarfComponentWait `json:"wait,omitempty" jsonschema:"description=Cluster resources and network endpoints to wait for after the component is deployed"`
 }
 
 // ZarfComponentOnlyTarget filters a component to only show it for a given local OS and cluster.
@@ -107,20 +116,71 @@ type ZarfComponentScripts struct {
 	After          []string `json:"after,omitempty" jsonschema:"description=Scripts to run after the component successfully deploys"`
 }
 
+// ZarfComponentActions are actions that run at different stages of the lifecycle of a component.
+type ZarfComponentActions struct {
+	OnCreate ZarfComponentActionSet `json:"onCreate,omitempty" jsonschema:"description=Actions to run while the component is added during package create"`
+	OnDeploy ZarfComponentActionSet `json:"onDeploy,omitempty" jsonschema:"description=Actions to run while the component is deployed"`
+	OnRemove ZarfComponentActionSet `json:"onRemove,omitempty" jsonschema:"description=Actions to run while the component is removed"`
+}
+
+// ZarfComponentActionSet is the set of actions that run before, after or on the failure of a stage of a component.
+type ZarfComponentActionSet struct {
+	Before    []ZarfComponentAction `json:"before,omitempty" jsonschema:"description=Actions to run at the start of the stage"`
+	After     []ZarfComponentAction `json:"after,omitempty" jsonschema:"description=Actions to run at the end of the stage if it succeeded"`
+	OnFailure []ZarfComponentAction `json:"onFailure,omitempty" jsonschema:"description=Actions to run if the stage failed"`
+}
+
+// ZarfComponentAction is a single command run as part of a component action set.
+type ZarfComponentAction struct {
+	Cmd             string   `json:"cmd" jsonschema:"description=The command to run (with sh on Linux and macOS or powershell on Windows)"`
+	Dir             string   `json:"dir,omitempty" jsonschema:"description=The working directory to run the command in (defaults to the current working directory)"`
+	Env             []string `json:"env,omitempty" jsonschema:"description=Additional environment variables to set for the command (KEY=value)"`
+	MaxRetries      int      `json:"maxRetries,omitempty" jsonschema:"description=The number of times to retry the command if it fails (defaults to 0)"`
+	MaxTotalSeconds int      `json:"maxTotalSeconds,omitempty" jsonschema:"description=The maximum time in seconds for the command to run, including retries (defaults to 0, no limit)"`
+	Mute            bool     `json:"mute,omitempty" jsonschema:"description=Hide the output of the command"`
+	SetVariable     string   `json:"setVariable,omitempty" jsonschema:"description=The name of a variable to set to the trimmed output of the command (onDeploy actions only),pattern=^[A-Z0-9_]+$"`
+}
+
+// ZarfComponentWait is a cluster resource or network endpoint to wait for.
+type ZarfComponentWait struct {
+	Cluster         *ZarfComponentWaitCluster `json:"cluster,omitempty" jsonschema:"description=Wait for a resource in the cluster"`
+	Network         *ZarfComponentWaitNetwork `json:"network,omitempty" jsonschema:"description=Wait for a network endpoint to respond"`
+	MaxTotalSeconds int                       `json:"maxTotalSeconds,omitempty" jsonschema:"description=The maximum time in seconds to wait (defaults to 300)"`
+}
+
+// ZarfComponentWaitCluster defines a resource in the cluster to wait for.
+type ZarfComponentWaitCluster struct {
+	Kind      string `json:"kind" jsonschema:"description=The kind of resource to wait for (e.g. Pod, deployment or helmreleases.helm.toolkit.fluxcd.io)"`
+	Name      string `json:"name" jsonschema:"description=The name of the resource, or a label selector (e.g. app=podinfo) matching the resources"`
+	Namespace string `json:"namespace,omitempty" jsonschema:"description=The namespace of the resource (defaults to default for namespaced resources)"`
+	Condition string `json:"condition,omitempty" jsonschema:"description=The status condition that must be True (e.g. Ready or Available), the resource only has to exist if no condition or jsonPath is given"`
+	JSONPath  string `json:"jsonPath,omitempty" jsonschema:"description=A JSONPath (e.g. {.status.phase}) that must be set, or must equal value if one is given"`
+	Value     string `json:"value,omitempty" jsonschema:"description=The value the jsonPath must equal"`
+}
+
+// ZarfComponentWaitNetwork defines a network endpoint to wait for.
+type ZarfComponentWaitNetwork struct {
+	Protocol string `json:"protocol" jsonschema:"description=The protocol of the endpoint,enum=tcp,enum=http,enum=https"`
+	Address  string `json:"address" jsonschema:"description=The address of the endpoint (host:port for tcp, or host[:port][/path] for http and https)"`
+	Code     int    `json:"code,omitempty" jsonschema:"description=The HTTP status code the endpoint must respond with (defaults to any 2xx code)"`
+}
+
 // ZarfContainerTarget defines the destination info for a ZarfData target.
 type ZarfContainerTarget struct {
 	Namespace string `json:"namespace" jsonschema:"description=The namespace to target for data injection"`
-	Selector  string `json:"selector" jsonschema:"description=The K8s selector to target for data injection"`
-	Container string `json:"container" jsonschema:"description=The container to target for data injection"`
+	Selector  string `json:"selector,omitempty" jsonschema:"description=The K8s selector to target for data injection"`
+	Container string `json:"container,omitempty" jsonschema:"description=The container to target for data injection"`
+	PVC       string `json:"pvc,omitempty" jsonschema:"description=The name of a PersistentVolumeClaim to inject the data into through a temporary pod, instead of a selector and container"`
 
-	Path string `json:"path" jsonschema:"description=The path to copy the data to in the container"`
+	Path string `json:"path" jsonschema:"description=The path to copy the data to in the container (or in the PersistentVolumeClaim)"`
 }
 
 // ZarfDataInjection is a data-injection definition.
 type ZarfDataInjection struct {
-	Source   string              `json:"source" jsonschema:"description=A path to a local folder or file to inject into the given target pod + container"`
-	Target   ZarfContainerTarget `json:"target" jsonschema:"description=The target pod + container to inject the data into"`
-	Compress bool                `json:"compress,omitempty" jsonschema:"description=Compress the data before transmitting using gzip.  Note: this requires support for tar/gzip locally and in the target image."`
+	Source          string              `json:"source" jsonschema:"description=A path to a local folder or file to inject into the given target pod + container"`
+	Target          ZarfContainerTarget `json:"target" jsonschema:"description=The target pod + container to inject the data into"`
+	Compress        bool                `json:"compress,omitempty" jsonschema:"description=Compress the data before transmitting using gzip.  Note: this requires support for gzip in the tar of the target image."`
+	MaxTotalSeconds int                 `json:"maxTotalSeconds,omitempty" jsonschema:"description=The maximum time in seconds for the injection, including waiting for the target pods (defaults to 900)"`
 }
 
 // ZarfComponentImport structure for including imported Zarf components.
@@ -13,6 +13,8 @@ type ZarfState struct {
 	Architecture  string           `json:"architecture" jsonschema:"description=Machine architecture of the k8s node(s)"`
 	StorageClass  string           `json:"storageClass" jsonschema:"Default StorageClass value Zarf uses for variable templating"`
 	AgentTLS      k8s.GeneratedPKI `json:"agentTLS" jsonschema:"PKI certificate information for the agent pods Zarf manages"`
+	ImagePolicy   string           `json:"imagePolicy,omitempty" jsonschema:"description=How the agent handles pods with images missing from the Zarf registry (off/warn/enforce)"`
+	ImagePinning  string           `json:"imagePinning,omitempty" jsonschema:"description=How the agent references the images of pods in the Zarf registry (off/digest)"`
 
 	GitServer     GitServerInfo `json:"gitServer" jsonschema:"description=Information about the repository Zarf is configured to use"`
 	RegistryInfo  RegistryInfo  `json:"registryInfo" jsonschema:"description=Information about the registry Zarf is configured to use"`
@@ -27,18 +29,46 @@ type DeployedPackage struct {
 	CLIVersion string      `json:"cliVersion"`
 
 	DeployedComponents []DeployedComponent `json:"deployedComponents"`
+
+	Generation int                         `json:"generation,omitempty"`
+	History    []DeployedPackageGeneration `json:"history,omitempty"`
+
+	// Progress is only set while a deployment of the package has not completed (e.g. after it failed part way through)
+	Progress *DeployProgress `json:"progress,omitempty"`
+}
+
+// DeployProgress records the components finished by a deployment that has not completed so that it can be resumed.
+type DeployProgress struct {
+	Checksum   string   `json:"checksum"`
+	Timestamp  string   `json:"timestamp"`
+	Components []string `json:"components"`
+}
+
+// DeployedPackageGeneration records a single deployment (or rollback) of a package so it can be rolled back to later.
+// The package definition of each generation is saved in its own secret (see config.ZarfGenerationPrefix).
+type DeployedPackageGeneration struct {
+	Generation int                 `json:"generation"`
+	Version    string              `json:"version"`
+	Timestamp  string              `json:"timestamp"`
+	Variables  map[string]string   `json:"variables,omitempty"`
+	Components []DeployedComponent `json:"components"`
 }
 
 // DeployedComponent contains information about a Zarf Package Component that has been deployed to a cluster.
 type DeployedComponent struct {
 	Name            string           `json:"name"`
 	InstalledCharts []InstalledChart `json:"installedCharts"`
+	Images          []string         `json:"images,omitempty"`
+	Repos           []string         `json:"repos,omitempty"`
+	Files           []string         `json:"files,omitempty"`
+	Symlinks        []string         `json:"symlinks,omitempty"`
 }
 
 // InstalledChart contains information about a Helm Chart that has been deployed to a cluster.
 type InstalledChart struct {
 	Namespace string `json:"namespace"`
 	ChartName string `json:"chartName"`
+	Revision  int    `json:"revision,omitempty"`
 }
 
 // GitServerInfo contains information Zarf uses to communicate with a git repository to push/pull repositories to.
@@ -33,14 +33,38 @@ type ZarfBuildData struct {
 	Architecture string `json:"architecture"`
 	Timestamp    string `json:"timestamp"`
 	Version      string `json:"version"`
+
+	Differential               bool     `json:"differential,omitempty"`
+	DifferentialPackageVersion string   `json:"differentialPackageVersion,omitempty"`
+	DifferentialMissing        []string `json:"differentialMissing,omitempty"`
+
+	ImageDigests      map[string]string `json:"imageDigests,omitempty"`
+	AggregateChecksum string            `json:"aggregateChecksum,omitempty"`
+}
+
+// ZarfLock pins the images of a package to the digests they were pulled at, it is kept next to the zarf.yaml.
+type ZarfLock struct {
+	Images []ZarfLockImage `json:"images"`
+}
+
+// ZarfLockImage is the digest an image is pinned to for an architecture in the zarf.lock.
+type ZarfLockImage struct {
+	Image        string `json:"image"`
+	Architecture string `json:"architecture"`
+	Digest       string `json:"digest"`
 }
 
 // ZarfPackageVariable are variables that can be used to dynamically template K8s resources.
 type ZarfPackageVariable struct {
-	Name        string `json:"name" jsonschema:"description=The name to be used for the variable,pattern=^[A-Z0-9_]+$"`
-	Description string `json:"description,omitempty" jsonschema:"description=A description of the variable to be used when prompting the user a value"`
-	Default     string `json:"default,omitempty" jsonschema:"description=The default value to use for the variable"`
-	Prompt      bool   `json:"prompt,omitempty" jsonschema:"description=Whether to prompt the user for input for this variable"`
+	Name         string `json:"name" jsonschema:"description=The name to be used for the variable,pattern=^[A-Z0-9_]+$"`
+	Description  string `json:"description,omitempty" jsonschema:"description=A description of the variable to be used when prompting the user a value"`
+	Default      string `json:"default,omitempty" jsonschema:"description=The default value to use for the variable"`
+	Prompt       bool   `json:"prompt,omitempty" jsonschema:"description=Whether to prompt the user for input for this variable"`
+	Path         string `json:"path,omitempty" jsonschema:"description=A chart value path (e.g. image.tag) that the value of the variable is set to in every chart, or prefixed with the component and chart it applies to (e.g. podinfo/podinfo:image.tag)"`
+	Pattern      string `json:"pattern,omitempty" jsonschema:"description=A regular expression the value of the variable must match"`
+	Type         string `json:"type,omitempty" jsonschema:"description=The type of the value of the variable (a file variable is set to the contents of the file at the given path),enum=string,enum=int,enum=bool,enum=file,default=string"`
+	Sensitive    bool   `json:"sensitive,omitempty" jsonschema:"description=Whether the value of the variable is a secret that should be masked in prompts and logs"`
+	AutoGenerate bool   `json:"autoGenerate,omitempty" jsonschema:"description=Whether to generate a random value for the variable if no value is set"`
 }
 
 // ZarfPackageConstant are constants that can be used to dynamically template K8s resources.
@@ -12,6 +12,12 @@ type PackagerConfig struct {
 	// DeployOpts tracks user-defined values for the active deployment
 	DeployOpts ZarfDeployOptions
 
+	// RemoveOpts tracks user-defined values for removing a deployed package
+	RemoveOpts ZarfRemoveOptions
+
+	// PublishOpts tracks user-defined values for publishing a package to an OCI registry
+	PublishOpts ZarfPublishOptions
+
 	// InitOpts tracks user-defined values for the active Zarf initialization.
 	InitOpts ZarfInitOptions
 
@@ -33,3 +39,31 @@ type PackagerConfig struct {
 	// SBOM file paths in the package
 	SBOMViewFiles []string
 }
+
+// DeployPlan describes the changes a package deployment would make to the cluster without applying them.
+type DeployPlan struct {
+	Package    string          `json:"package"`
+	Version    string          `json:"version,omitempty"`
+	Components []ComponentPlan `json:"components"`
+}
+
+// ComponentPlan describes the changes deploying a single component would make.
+type ComponentPlan struct {
+	Name           string        `json:"name"`
+	Namespaces     []string      `json:"namespaces,omitempty"`
+	Releases       []ReleasePlan `json:"releases,omitempty"`
+	Images         []string      `json:"images,omitempty"`
+	Repos          []string      `json:"repos,omitempty"`
+	Scripts        []string      `json:"scripts,omitempty"`
+	Files          []string      `json:"files,omitempty"`
+	DataInjections []string      `json:"dataInjections,omitempty"`
+}
+
+// ReleasePlan describes a helm release that would be installed or upgraded.
+type ReleasePlan struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     string `json:"chart"`
+	Action    string `json:"action"`
+	Diff      string `json:"diff,omitempty"`
+}
@@ -13,12 +13,22 @@ type ZarfCommonOptions struct {
 
 // ZarfDeployOptions tracks the user-defined preferences during a package deployment.
 type ZarfDeployOptions struct {
-	Insecure     bool              `json:"insecure" jsonschema:"description=Allow insecure connections for remote packages"`
-	Shasum       string            `json:"shasum" jsonschema:"description=The SHA256 checksum of the package to deploy"`
-	PackagePath  string            `json:"packagePath" jsonschema:"description=Location where a Zarf package to deploy can be found"`
-	Components   string            `json:"components" jsonschema:"description=Comma separated list of optional components to deploy"`
-	SGetKeyPath  string            `json:"sGetKeyPath" jsonschema:"description=Location where the public key component of a cosign key-pair can be found"`
-	SetVariables map[string]string `json:"setVariables" jsonschema:"description=Key-Value map of variable names and their corresponding values that will be used to template against the Zarf package being used"`
+	Insecure        bool              `json:"insecure" jsonschema:"description=Allow insecure connections for remote packages"`
+	Shasum          string            `json:"shasum" jsonschema:"description=The SHA256 checksum of the package to deploy"`
+	PackagePath     string            `json:"packagePath" jsonschema:"description=Location where a Zarf package to deploy can be found"`
+	Components      string            `json:"components" jsonschema:"description=Comma separated list of optional components to deploy"`
+	SGetKeyPath     string            `json:"sGetKeyPath" jsonschema:"description=Location where the public key component of a cosign key-pair can be found"`
+	SetVariables    map[string]string `json:"setVariables" jsonschema:"description=Key-Value map of variable names and their corresponding values that will be used to template against the Zarf package being used"`
+	DryRun          bool              `json:"dryRun" jsonschema:"description=Report the changes the deployment would make without applying them"`
+	Resume          bool              `json:"resume" jsonschema:"description=Skip the components already deployed by an earlier failed attempt to deploy the same package"`
+	Concurrency     int               `json:"concurrency" jsonschema:"description=Number of images to push to the registry at the same time"`
+	PublicKeyPath   string            `json:"publicKeyPath" jsonschema:"description=Location of the cosign public key used to verify the package signature"`
+	ValuesOverrides map[string]string `json:"valuesOverrides" jsonschema:"description=Map of <component>/<chart> to a values file to merge on top of the packaged values of that chart"`
+}
+
+// ZarfRemoveOptions tracks the user-defined options used to remove a deployed package.
+type ZarfRemoveOptions struct {
+	Purge bool `json:"purge" jsonschema:"description=Remove the images, repos, files and symlinks the package components produced"`
 }
 
 // ZarfInitOptions tracks the user-defined options during cluster initialization.
@@ -32,18 +42,33 @@ type ZarfInitOptions struct {
 	RegistryInfo RegistryInfo `json:"registryInfo" jsonschema:"description=Information about the registry Zarf is going to be using"`
 
 	StorageClass string `json:"storageClass" jsonschema:"description=StorageClass of the k8s cluster Zarf is initializing"`
+
+	ImagePolicy string `json:"imagePolicy,omitempty" jsonschema:"description=How the agent handles pods with images missing from the Zarf registry (off/warn/enforce)"`
+
+	ImagePinning string `json:"imagePinning,omitempty" jsonschema:"description=How the agent references the images of pods in the Zarf registry (off/digest)"`
 }
 
 // ZarfCreateOptions tracks the user-defined options used to create the package.
 type ZarfCreateOptions struct {
-	SkipSBOM         bool              `json:"skipSBOM" jsonschema:"description=Disable the generation of SBOM materials during package creation"`
-	Insecure         bool              `json:"insecure" jsonschema:"description=Disable the need for shasum validations when pulling down files from the internet"`
-	OutputDirectory  string            `json:"outputDirectory" jsonschema:"description=Location where the finalized Zarf package will be placed"`
-	ViewSBOM         bool              `json:"sbom" jsonschema:"description=Whether to pause to allow for viewing the SBOM post-creation"`
-	SBOMOutputDir    string            `json:"sbomOutput" jsonschema:"description=Location to output an SBOM into after package creation"`
-	SetVariables     map[string]string `json:"setVariables" jsonschema:"description=Key-Value map of variable names and their corresponding values that will be used to template against the Zarf package being used"`
-	MaxPackageSizeMB int               `json:"maxPackageSizeMB" jsonschema:"description=Size of chunks to use when splitting a zarf package into multiple files in megabytes"`
-	NoLocalImages    bool              `json:"noLocalImages" jsonschema:"description=Disable the use of local container images during package creation"`
+	SkipSBOM           bool              `json:"skipSBOM" jsonschema:"description=Disable the generation of SBOM materials during package creation"`
+	Insecure           bool              `json:"insecure" jsonschema:"description=Disable the need for shasum validations when pulling down files from the internet"`
+	OutputDirectory    string            `json:"outputDirectory" jsonschema:"description=Location where the finalized Zarf package will be placed"`
+	ViewSBOM           bool              `json:"sbom" jsonschema:"description=Whether to pause to allow for viewing the SBOM post-creation"`
+	SBOMOutputDir      string            `json:"sbomOutput" jsonschema:"description=Location to output an SBOM into after package creation"`
+	SetVariables       map[string]string `json:"setVariables" jsonschema:"description=Key-Value map of variable names and their corresponding values that will be used to template against the Zarf package being used"`
+	MaxPackageSizeMB   int               `json:"maxPackageSizeMB" jsonschema:"description=Size of chunks to use when splitting a zarf package into multiple files in megabytes"`
+	NoLocalImages      bool              `json:"noLocalImages" jsonschema:"description=Disable the use of local container images during package creation"`
+	Differential       string            `json:"differential" jsonschema:"description=Path to a previously built package to create a differential package against"`
+	Concurrency        int               `json:"concurrency" jsonschema:"description=Number of images to pull at the same time"`
+	UpdateLock         bool              `json:"updateLock" jsonschema:"description=Pull the image tags instead of the locked digests and update the zarf.lock with the new digests"`
+	SigningKeyPath     string            `json:"signingKeyPath" jsonschema:"description=Location of the cosign private key used to sign the package"`
+	SigningKeyPassword string            `json:"signingKeyPassword" jsonschema:"description=Password of the cosign private key used to sign the package"`
+}
+
+// ZarfPublishOptions tracks the user-defined options used to publish a package to an OCI registry.
+type ZarfPublishOptions struct {
+	Reference string `json:"reference" jsonschema:"description=The oci:// reference to publish the package to"`
+	Insecure  bool   `json:"insecure" jsonschema:"description=Allow insecure (plain HTTP) connections to the OCI registry"`
 }
 
 // ZarfPartialPackageData contains info about a partial package.
@@ -31,6 +31,14 @@ export interface ZarfDeployOptions {
      * Comma separated list of optional components to deploy
      */
     components: string;
+    /**
+     * Number of images to push to the registry at the same time
+     */
+    concurrency: number;
+    /**
+     * Report the changes the deployment would make without applying them
+     */
+    dryRun: boolean;
     /**
      * Allow insecure connections for remote packages
      */
@@ -39,6 +47,15 @@ export interface ZarfDeployOptions {
      * Location where a Zarf package to deploy can be found
      */
     packagePath: string;
+    /**
+     * Location of the cosign public key used to verify the package signature
+     */
+    publicKeyPath: string;
+    /**
+     * Skip the components already deployed by an earlier failed attempt to deploy the same
+     * package
+     */
+    resume: boolean;
     /**
      * Key-Value map of variable names and their corresponding values that will be used to
      * template against the Zarf package being used
@@ -52,6 +69,11 @@ export interface ZarfDeployOptions {
      * The SHA256 checksum of the package to deploy
      */
     shasum: string;
+    /**
+     * Map of <component>/<chart> to a values file to merge on top of the packaged values of
+     * that chart
+     */
+    valuesOverrides: { [key: string]: string };
 }
 
 export interface ZarfInitOptions {
@@ -63,6 +85,14 @@ export interface ZarfInitOptions {
      * Information about the repository Zarf is going to be using
      */
     gitServer: GitServerInfo;
+    /**
+     * How the agent references the images of pods in the Zarf registry (off/digest)
+     */
+    imagePinning?: string;
+    /**
+     * How the agent handles pods with images missing from the Zarf registry (off/warn/enforce)
+     */
+    imagePolicy?: string;
     /**
      * Information about the registry Zarf is going to be using
      */
@@ -186,14 +216,23 @@ export interface ZarfPackage {
  * Zarf-generated package build data
  */
 export interface ZarfBuildData {
-    architecture: string;
-    terminal:     string;
-    timestamp:    string;
-    user:         string;
-    version:      string;
+    aggregateChecksum?:          string;
+    architecture:                string;
+    differential?:               boolean;
+    differentialMissing?:        string[];
+    differentialPackageVersion?: string;
+    imageDigests?:               { [key: string]: string };
+    terminal:                    string;
+    timestamp:                   string;
+    user:                        string;
+    version:                     string;
 }
 
 export interface ZarfComponent {
+    /**
+     * Custom commands to run at different stages of package create, deploy and remove
+     */
+    actions?: ZarfComponentActions;
     /**
      * Helm charts to install during package deploy
      */
@@ -210,6 +249,10 @@ export interface ZarfComponent {
      * Determines the default Y/N state for installing this component on package deploy
      */
     default?: boolean;
+    /**
+     * Names of other components in this package that must be deployed before this component
+     */
+    dependsOn?: string[];
     /**
      * Message to include during package deploy describing the purpose of this component
      */
@@ -251,6 +294,82 @@ export interface ZarfComponent {
      * Custom commands to run before or after package deployment
      */
     scripts?: ZarfComponentScripts;
+    /**
+     * Cluster resources and network endpoints to wait for after the component is deployed
+     */
+    wait?: ZarfComponentWait[];
+}
+
+/**
+ * Custom commands to run at different stages of package create, deploy and remove
+ */
+export interface ZarfComponentActions {
+    /**
+     * Actions to run while the component is added during package create
+     */
+    onCreate?: ZarfComponentActionSet;
+    /**
+     * Actions to run while the component is deployed
+     */
+    onDeploy?: ZarfComponentActionSet;
+    /**
+     * Actions to run while the component is removed
+     */
+    onRemove?: ZarfComponentActionSet;
+}
+
+/**
+ * Actions to run while the component is added during package create
+ *
+ * Actions to run while the component is deployed
+ *
+ * Actions to run while the component is removed
+ */
+export interface ZarfComponentActionSet {
+    /**
+     * Actions to run at the end of the stage if it succeeded
+     */
+    after?: ZarfComponentAction[];
+    /**
+     * Actions to run at the start of the stage
+     */
+    before?: ZarfComponentAction[];
+    /**
+     * Actions to run if the stage failed
+     */
+    onFailure?: ZarfComponentAction[];
+}
+
+export interface ZarfComponentAction {
+    /**
+     * The command to run (with sh on Linux and macOS or powershell on Windows)
+     */
+    cmd: string;
+    /**
+     * The working directory to run the command in (defaults to the current working directory)
+     */
+    dir?: string;
+    /**
+     * Additional environment variables to set for the command (KEY=value)
+     */
+    env?: string[];
+    /**
+     * The number of times to retry the command if it fails (defaults to 0)
+     */
+    maxRetries?: number;
+    /**
+     * The maximum time in seconds for the command to run, including retries (defaults to 0, no
+     * limit)
+     */
+    maxTotalSeconds?: number;
+    /**
+     * Hide the output of the command
+     */
+    mute?: boolean;
+    /**
+     * The name of a variable to set to the trimmed output of the command (onDeploy actions only)
+     */
+    setVariable?: string;
 }
 
 export interface ZarfChart {
@@ -297,10 +416,15 @@ export interface ZarfChart {
 
 export interface ZarfDataInjection {
     /**
-     * Compress the data before transmitting using gzip.  Note: this requires support for
-     * tar/gzip locally and in the target image.
+     * Compress the data before transmitting using gzip.  Note: this requires support for gzip
+     * in the tar of the target image.
      */
     compress?: boolean;
+    /**
+     * The maximum time in seconds for the injection, including waiting for the target pods
+     * (defaults to 900)
+     */
+    maxTotalSeconds?: number;
     /**
      * A path to a local folder or file to inject into the given target pod + container
      */
@@ -318,19 +442,24 @@ export interface ZarfContainerTarget {
     /**
      * The container to target for data injection
      */
-    container: string;
+    container?: string;
     /**
      * The namespace to target for data injection
      */
     namespace: string;
     /**
-     * The path to copy the data to in the container
+     * The path to copy the data to in the container (or in the PersistentVolumeClaim)
      */
     path: string;
+    /**
+     * The name of a PersistentVolumeClaim to inject the data into through a temporary pod,
+     * instead of a selector and container
+     */
+    pvc?: string;
     /**
      * The K8s selector to target for data injection
      */
-    selector: string;
+    selector?: string;
 }
 
 export interface ZarfFile {
@@ -467,6 +596,80 @@ export interface ZarfComponentScripts {
     timeoutSeconds?: number;
 }
 
+export interface ZarfComponentWait {
+    /**
+     * Wait for a resource in the cluster
+     */
+    cluster?: ZarfComponentWaitCluster;
+    /**
+     * The maximum time in seconds to wait (defaults to 300)
+     */
+    maxTotalSeconds?: number;
+    /**
+     * Wait for a network endpoint to respond
+     */
+    network?: ZarfComponentWaitNetwork;
+}
+
+/**
+ * Wait for a resource in the cluster
+ */
+export interface ZarfComponentWaitCluster {
+    /**
+     * The status condition that must be True (e.g. Ready or Available), the resource only has
+     * to exist if no condition or jsonPath is given
+     */
+    condition?: string;
+    /**
+     * A JSONPath (e.g. {.status.phase}) that must be set, or must equal value if one is given
+     */
+    jsonPath?: string;
+    /**
+     * The kind of resource to wait for (e.g. Pod, deployment or
+     * helmreleases.helm.toolkit.fluxcd.io)
+     */
+    kind: string;
+    /**
+     * The name of the resource, or a label selector (e.g. app=podinfo) matching the resources
+     */
+    name: string;
+    /**
+     * The namespace of the resource (defaults to default for namespaced resources)
+     */
+    namespace?: string;
+    /**
+     * The value the jsonPath must equal
+     */
+    value?: string;
+}
+
+/**
+ * Wait for a network endpoint to respond
+ */
+export interface ZarfComponentWaitNetwork {
+    /**
+     * The address of the endpoint (host:port for tcp, or host[:port][/path] for http and https)
+     */
+    address: string;
+    /**
+     * The HTTP status code the endpoint must respond with (defaults to any 2xx code)
+     */
+    code?: number;
+    /**
+     * The protocol of the endpoint
+     */
+    protocol: Protocol;
+}
+
+/**
+ * The protocol of the endpoint
+ */
+export enum Protocol {
+    HTTP = "http",
+    HTTPS = "https",
+    TCP = "tcp",
+}
+
 export interface ZarfPackageConstant {
     /**
      * A description of the constant to explain its purpose on package create or deploy
@@ -532,6 +735,10 @@ export interface ZarfMetadata {
 }
 
 export interface ZarfPackageVariable {
+    /**
+     * Whether to generate a random value for the variable if no value is set
+     */
+    autoGenerate?: boolean;
     /**
      * The default value to use for the variable
      */
@@ -544,10 +751,40 @@ export interface ZarfPackageVariable {
      * The name to be used for the variable
      */
     name: string;
+    /**
+     * A chart value path (e.g. image.tag) that the value of the variable is set to in every
+     * chart, or prefixed with the component and chart it applies to (e.g.
+     * podinfo/podinfo:image.tag)
+     */
+    path?: string;
+    /**
+     * A regular expression the value of the variable must match
+     */
+    pattern?: string;
     /**
      * Whether to prompt the user for input for this variable
      */
     prompt?: boolean;
+    /**
+     * Whether the value of the variable is a secret that should be masked in prompts and logs
+     */
+    sensitive?: boolean;
+    /**
+     * The type of the value of the variable (a file variable is set to the contents of the file
+     * at the given path)
+     */
+    type?: Type;
+}
+
+/**
+ * The type of the value of the variable (a file variable is set to the contents of the file
+ * at the given path)
+ */
+export enum Type {
+    Bool = "bool",
+    File = "file",
+    Int = "int",
+    String = "string",
 }
 
 export interface ClusterSummary {
@@ -571,6 +808,14 @@ export interface ZarfState {
      * Information about the repository Zarf is configured to use
      */
     gitServer: GitServerInfo;
+    /**
+     * How the agent references the images of pods in the Zarf registry (off/digest)
+     */
+    imagePinning?: string;
+    /**
+     * How the agent handles pods with images missing from the Zarf registry (off/warn/enforce)
+     */
+    imagePolicy?: string;
     /**
      * Secret value that the internal Grafana server was seeded with
      */
@@ -607,17 +852,39 @@ export interface DeployedPackage {
     cliVersion:         string;
     data:               ZarfPackage;
     deployedComponents: DeployedComponent[];
+    generation?:        number;
+    history?:           DeployedPackageGeneration[];
     name:               string;
+    progress?:          DeployProgress;
 }
 
 export interface DeployedComponent {
+    files?:          string[];
+    images?:         string[];
     installedCharts: InstalledChart[];
     name:            string;
+    repos?:          string[];
+    symlinks?:       string[];
 }
 
 export interface InstalledChart {
     chartName: string;
     namespace: string;
+    revision?: number;
+}
+
+export interface DeployedPackageGeneration {
+    components: DeployedComponent[];
+    generation: number;
+    timestamp:  string;
+    variables?: { [key: string]: string };
+    version:    string;
+}
+
+export interface DeployProgress {
+    checksum:   string;
+    components: string[];
+    timestamp:  string;
 }
 
 export interface ZarfCommonOptions {
@@ -637,6 +904,14 @@ export interface ZarfCommonOptions {
 }
 
 export interface ZarfCreateOptions {
+    /**
+     * Number of images to pull at the same time
+     */
+    concurrency: number;
+    /**
+     * Path to a previously built package to create a differential package against
+     */
+    differential: string;
     /**
      * Disable the need for shasum validations when pulling down files from the internet
      */
@@ -666,10 +941,22 @@ export interface ZarfCreateOptions {
      * template against the Zarf package being used
      */
     setVariables: { [key: string]: string };
+    /**
+     * Password of the cosign private key used to sign the package
+     */
+    signingKeyPassword: string;
+    /**
+     * Location of the cosign private key used to sign the package
+     */
+    signingKeyPath: string;
     /**
      * Disable the generation of SBOM materials during package creation
      */
     skipSBOM: boolean;
+    /**
+     * Pull the image tags instead of the locked digests and update the zarf.lock with the new digests
+     */
+    updateLock: boolean;
 }
 
 // Converts JSON strings to/from your types
@@ -856,15 +1143,22 @@ const typeMap: any = {
     ], false),
     "ZarfDeployOptions": o([
         { json: "components", js: "components", typ: "" },
+        { json: "concurrency", js: "concurrency", typ: 0 },
+        { json: "dryRun", js: "dryRun", typ: true },
         { json: "insecure", js: "insecure", typ: true },
         { json: "packagePath", js: "packagePath", typ: "" },
+        { json: "publicKeyPath", js: "publicKeyPath", typ: "" },
+        { json: "resume", js: "resume", typ: true },
         { json: "setVariables", js: "setVariables", typ: m("") },
         { json: "sGetKeyPath", js: "sGetKeyPath", typ: "" },
         { json: "shasum", js: "shasum", typ: "" },
+        { json: "valuesOverrides", js: "valuesOverrides", typ: m("") },
     ], false),
     "ZarfInitOptions": o([
         { json: "applianceMode", js: "applianceMode", typ: true },
         { json: "gitServer", js: "gitServer", typ: r("GitServerInfo") },
+        { json: "imagePinning", js: "imagePinning", typ: u(undefined, "") },
+        { json: "imagePolicy", js: "imagePolicy", typ: u(undefined, "") },
         { json: "registryInfo", js: "registryInfo", typ: r("RegistryInfo") },
         { json: "storageClass", js: "storageClass", typ: "" },
     ], false),
@@ -899,17 +1193,24 @@ const typeMap: any = {
         { json: "variables", js: "variables", typ: u(undefined, a(r("ZarfPackageVariable"))) },
     ], false),
     "ZarfBuildData": o([
+        { json: "aggregateChecksum", js: "aggregateChecksum", typ: u(undefined, "") },
         { json: "architecture", js: "architecture", typ: "" },
+        { json: "differential", js: "differential", typ: u(undefined, true) },
+        { json: "differentialMissing", js: "differentialMissing", typ: u(undefined, a("")) },
+        { json: "differentialPackageVersion", js: "differentialPackageVersion", typ: u(undefined, "") },
+        { json: "imageDigests", js: "imageDigests", typ: u(undefined, m("")) },
         { json: "terminal", js: "terminal", typ: "" },
         { json: "timestamp", js: "timestamp", typ: "" },
         { json: "user", js: "user", typ: "" },
         { json: "version", js: "version", typ: "" },
     ], false),
     "ZarfComponent": o([
+        { json: "actions", js: "actions", typ: u(undefined, r("ZarfComponentActions")) },
         { json: "charts", js: "charts", typ: u(undefined, a(r("ZarfChart"))) },
         { json: "cosignKeyPath", js: "cosignKeyPath", typ: u(undefined, "") },
         { json: "dataInjections", js: "dataInjections", typ: u(undefined, a(r("ZarfDataInjection"))) },
         { json: "default", js: "default", typ: u(undefined, true) },
+        { json: "dependsOn", js: "dependsOn", typ: u(undefined, a("")) },
         { json: "description", js: "description", typ: u(undefined, "") },
         { json: "files", js: "files", typ: u(undefined, a(r("ZarfFile"))) },
         { json: "group", js: "group", typ: u(undefined, "") },
@@ -921,6 +1222,26 @@ const typeMap: any = {
         { json: "repos", js: "repos", typ: u(undefined, a("")) },
         { json: "required", js: "required", typ: u(undefined, true) },
         { json: "scripts", js: "scripts", typ: u(undefined, r("ZarfComponentScripts")) },
+        { json: "wait", js: "wait", typ: u(undefined, a(r("ZarfComponentWait"))) },
+    ], false),
+    "ZarfComponentActions": o([
+        { json: "onCreate", js: "onCreate", typ: u(undefined, r("ZarfComponentActionSet")) },
+        { json: "onDeploy", js: "onDeploy", typ: u(undefined, r("ZarfComponentActionSet")) },
+        { json: "onRemove", js: "onRemove", typ: u(undefined, r("ZarfComponentActionSet")) },
+    ], false),
+    "ZarfComponentActionSet": o([
+        { json: "after", js: "after", typ: u(undefined, a(r("ZarfComponentAction"))) },
+        { json: "before", js: "before", typ: u(undefined, a(r("ZarfComponentAction"))) },
+        { json: "onFailure", js: "onFailure", typ: u(undefined, a(r("ZarfComponentAction"))) },
+    ], false),
+    "ZarfComponentAction": o([
+        { json: "cmd", js: "cmd", typ: "" },
+        { json: "dir", js: "dir", typ: u(undefined, "") },
+        { json: "env", js: "env", typ: u(undefined, a("")) },
+        { json: "maxRetries", js: "maxRetries", typ: u(undefined, 0) },
+        { json: "maxTotalSeconds", js: "maxTotalSeconds", typ: u(undefined, 0) },
+        { json: "mute", js: "mute", typ: u(undefined, true) },
+        { json: "setVariable", js: "setVariable", typ: u(undefined, "") },
     ], false),
     "ZarfChart": o([
         { json: "gitPath", js: "gitPath", typ: u(undefined, "") },
@@ -935,14 +1256,16 @@ const typeMap: any = {
     ], false),
     "ZarfDataInjection": o([
         { json: "compress", js: "compress", typ: u(undefined, true) },
+        { json: "maxTotalSeconds", js: "maxTotalSeconds", typ: u(undefined, 0) },
         { json: "source", js: "source", typ: "" },
         { json: "target", js: "target", typ: r("ZarfContainerTarget") },
     ], false),
     "ZarfContainerTarget": o([
-        { json: "container", js: "container", typ: "" },
+        { json: "container", js: "container", typ: u(undefined, "") },
         { json: "namespace", js: "namespace", typ: "" },
         { json: "path", js: "path", typ: "" },
-        { json: "selector", js: "selector", typ: "" },
+        { json: "pvc", js: "pvc", typ: u(undefined, "") },
+        { json: "selector", js: "selector", typ: u(undefined, "") },
     ], false),
     "ZarfFile": o([
         { json: "executable", js: "executable", typ: u(undefined, true) },
@@ -979,6 +1302,24 @@ const typeMap: any = {
         { json: "showOutput", js: "showOutput", typ: u(undefined, true) },
         { json: "timeoutSeconds", js: "timeoutSeconds", typ: u(undefined, 0) },
     ], false),
+    "ZarfComponentWait": o([
+        { json: "cluster", js: "cluster", typ: u(undefined, r("ZarfComponentWaitCluster")) },
+        { json: "maxTotalSeconds", js: "maxTotalSeconds", typ: u(undefined, 0) },
+        { json: "network", js: "network", typ: u(undefined, r("ZarfComponentWaitNetwork")) },
+    ], false),
+    "ZarfComponentWaitCluster": o([
+        { json: "condition", js: "condition", typ: u(undefined, "") },
+        { json: "jsonPath", js: "jsonPath", typ: u(undefined, "") },
+        { json: "kind", js: "kind", typ: "" },
+        { json: "name", js: "name", typ: "" },
+        { json: "namespace", js: "namespace", typ: u(undefined, "") },
+        { json: "value", js: "value", typ: u(undefined, "") },
+    ], false),
+    "ZarfComponentWaitNetwork": o([
+        { json: "address", js: "address", typ: "" },
+        { json: "code", js: "code", typ: u(undefined, 0) },
+        { json: "protocol", js: "protocol", typ: r("Protocol") },
+    ], false),
     "ZarfPackageConstant": o([
         { json: "description", js: "description", typ: u(undefined, "") },
         { json: "name", js: "name", typ: "" },
@@ -995,10 +1336,15 @@ const typeMap: any = {
         { json: "yolo", js: "yolo", typ: u(undefined, true) },
     ], false),
     "ZarfPackageVariable": o([
+        { json: "autoGenerate", js: "autoGenerate", typ: u(undefined, true) },
         { json: "default", js: "default", typ: u(undefined, "") },
         { json: "description", js: "description", typ: u(undefined, "") },
         { json: "name", js: "name", typ: "" },
+        { json: "path", js: "path", typ: u(undefined, "") },
+        { json: "pattern", js: "pattern", typ: u(undefined, "") },
         { json: "prompt", js: "prompt", typ: u(undefined, true) },
+        { json: "sensitive", js: "sensitive", typ: u(undefined, true) },
+        { json: "type", js: "type", typ: u(undefined, r("Type")) },
     ], false),
     "ClusterSummary": o([
         { json: "distro", js: "distro", typ: "" },
@@ -1011,6 +1357,8 @@ const typeMap: any = {
         { json: "architecture", js: "architecture", typ: "" },
         { json: "distro", js: "distro", typ: "" },
         { json: "gitServer", js: "gitServer", typ: r("GitServerInfo") },
+        { json: "imagePinning", js: "imagePinning", typ: u(undefined, "") },
+        { json: "imagePolicy", js: "imagePolicy", typ: u(undefined, "") },
         { json: "loggingSecret", js: "loggingSecret", typ: "" },
         { json: "registryInfo", js: "registryInfo", typ: r("RegistryInfo") },
         { json: "storageClass", js: "storageClass", typ: "" },
@@ -1029,15 +1377,35 @@ const typeMap: any = {
         { json: "cliVersion", js: "cliVersion", typ: "" },
         { json: "data", js: "data", typ: r("ZarfPackage") },
         { json: "deployedComponents", js: "deployedComponents", typ: a(r("DeployedComponent")) },
+        { json: "generation", js: "generation", typ: u(undefined, 0) },
+        { json: "history", js: "history", typ: u(undefined, a(r("DeployedPackageGeneration"))) },
         { json: "name", js: "name", typ: "" },
+        { json: "progress", js: "progress", typ: u(undefined, r("DeployProgress")) },
     ], false),
     "DeployedComponent": o([
+        { json: "files", js: "files", typ: u(undefined, a("")) },
+        { json: "images", js: "images", typ: u(undefined, a("")) },
         { json: "installedCharts", js: "installedCharts", typ: a(r("InstalledChart")) },
         { json: "name", js: "name", typ: "" },
+        { json: "repos", js: "repos", typ: u(undefined, a("")) },
+        { json: "symlinks", js: "symlinks", typ: u(undefined, a("")) },
     ], false),
     "InstalledChart": o([
         { json: "chartName", js: "chartName", typ: "" },
         { json: "namespace", js: "namespace", typ: "" },
+        { json: "revision", js: "revision", typ: u(undefined, 0) },
+    ], false),
+    "DeployedPackageGeneration": o([
+        { json: "components", js: "components", typ: a(r("DeployedComponent")) },
+        { json: "generation", js: "generation", typ: 0 },
+        { json: "timestamp", js: "timestamp", typ: "" },
+        { json: "variables", js: "variables", typ: u(undefined, m("")) },
+        { json: "version", js: "version", typ: "" },
+    ], false),
+    "DeployProgress": o([
+        { json: "checksum", js: "checksum", typ: "" },
+        { json: "components", js: "components", typ: a("") },
+        { json: "timestamp", js: "timestamp", typ: "" },
     ], false),
     "ZarfCommonOptions": o([
         { json: "cachePath", js: "cachePath", typ: "" },
@@ -1045,6 +1413,8 @@ const typeMap: any = {
         { json: "tempDirectory", js: "tempDirectory", typ: "" },
     ], false),
     "ZarfCreateOptions": o([
+        { json: "concurrency", js: "concurrency", typ: 0 },
+        { json: "differential", js: "differential", typ: "" },
         { json: "insecure", js: "insecure", typ: true },
         { json: "maxPackageSizeMB", js: "maxPackageSizeMB", typ: 0 },
         { json: "noLocalImages", js: "noLocalImages", typ: true },
@@ -1052,7 +1422,10 @@ const typeMap: any = {
         { json: "sbom", js: "sbom", typ: true },
         { json: "sbomOutput", js: "sbomOutput", typ: "" },
         { json: "setVariables", js: "setVariables", typ: m("") },
+        { json: "signingKeyPassword", js: "signingKeyPassword", typ: "" },
+        { json: "signingKeyPath", js: "signingKeyPath", typ: "" },
         { json: "skipSBOM", js: "skipSBOM", typ: true },
+        { json: "updateLock", js: "updateLock", typ: true },
     ], false),
     "Architecture": [
         "amd64",
@@ -1063,8 +1436,19 @@ const typeMap: any = {
         "linux",
         "windows",
     ],
+    "Protocol": [
+        "http",
+        "https",
+        "tcp",
+    ],
     "Kind": [
         "ZarfInitConfig",
         "ZarfPackageConfig",
     ],
+    "Type": [
+        "bool",
+        "file",
+        "int",
+        "string",
+    ],
 };
@@ -30,7 +30,7 @@ const Cluster = {
 	summary: () => http.get<ClusterSummary>('/cluster'),
 	state: {
 		read: () => http.get<ZarfState>('/state'),
-		update: (body: ZarfState) => http.patch<ZarfState>('/state', body)
+		update: (body: ZarfState) => http.put<ZarfState>('/state', body)
 	}
 };
 